@@ -0,0 +1,20 @@
+# The access token is created for the user the provider is authenticated as, so a bot account
+# manages its own tokens through a provider configured with its credentials.
+provider "gocd" {
+  alias    = "deploy-bot"
+  baseurl  = "https://ci.example.com/go"
+  username = "deploy-bot"
+  password = var.deploy_bot_password
+}
+
+resource "gocd_access_token" "deploy" {
+  provider    = gocd.deploy-bot
+  description = "Deployments from the release pipeline"
+}
+
+# Other providers and tools can then authenticate with the token.
+provider "gocd" {
+  alias   = "deploy"
+  baseurl = "https://ci.example.com/go"
+  token   = gocd_access_token.deploy.token
+}
@@ -0,0 +1,13 @@
+resource "gocd_agent" "build-agent" {
+  uuid               = "f5cf5a8e-5a4d-4b1f-9bd5-7e1c3b1a6d92"
+  agent_config_state = "Enabled"
+
+  resources = [
+    "linux",
+    "docker",
+  ]
+
+  environments = [
+    "production",
+  ]
+}
@@ -0,0 +1,45 @@
+resource "gocd_artifact_store" "dockerhub" {
+  store_id  = "dockerhub"
+  plugin_id = "cd.go.artifact.docker.registry"
+
+  properties {
+    key   = "RegistryType"
+    value = "other"
+  }
+
+  properties {
+    key   = "RegistryURL"
+    value = "https://index.docker.io/v1/"
+  }
+
+  properties {
+    key   = "Username"
+    value = "gocd"
+  }
+
+  properties {
+    key   = "Password"
+    value = var.dockerhub_password
+  }
+}
+
+data "gocd_job_definition" "publish" {
+  name  = "publish"
+  tasks = [data.gocd_task_definition.docker_build.json]
+
+  artifacts {
+    type        = "external"
+    artifact_id = "app-image"
+    store_id    = gocd_artifact_store.dockerhub.store_id
+
+    configuration {
+      key   = "Image"
+      value = "example/app"
+    }
+
+    configuration {
+      key   = "Tag"
+      value = "$${GO_PIPELINE_LABEL}"
+    }
+  }
+}
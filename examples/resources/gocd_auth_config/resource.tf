@@ -0,0 +1,42 @@
+resource "gocd_auth_config" "ldap" {
+  auth_config_id                  = "ldap"
+  plugin_id                       = "cd.go.authentication.ldap"
+  allow_only_known_users_to_login = false
+  verify_connection               = true
+
+  properties {
+    key   = "Url"
+    value = "ldap://ldap.example.com"
+  }
+
+  properties {
+    key   = "ManagerDN"
+    value = "uid=admin,ou=system"
+  }
+
+  properties {
+    key   = "Password"
+    value = var.ldap_manager_password
+  }
+
+  properties {
+    key   = "SearchBases"
+    value = "ou=users,dc=example,dc=com"
+  }
+
+  properties {
+    key   = "UserLoginFilter"
+    value = "(uid={0})"
+  }
+}
+
+resource "gocd_role" "ldap-admins" {
+  name           = "ldap-admins"
+  type           = "plugin"
+  auth_config_id = gocd_auth_config.ldap.auth_config_id
+
+  properties {
+    key   = "GroupIdentifiers"
+    value = "ou=admins,ou=groups,dc=example,dc=com"
+  }
+}
@@ -0,0 +1,24 @@
+resource "gocd_cluster_profile" "docker" {
+  profile_id = "docker"
+  plugin_id  = "cd.go.contrib.elastic-agent.docker"
+
+  properties {
+    key   = "go_server_url"
+    value = "https://gocd.example.com/go"
+  }
+
+  properties {
+    key   = "docker_uri"
+    value = "unix:///var/run/docker.sock"
+  }
+
+  properties {
+    key   = "max_docker_containers"
+    value = "10"
+  }
+
+  properties {
+    key   = "auto_register_timeout"
+    value = "10"
+  }
+}
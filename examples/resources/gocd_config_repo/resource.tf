@@ -0,0 +1,18 @@
+resource "gocd_config_repo" "pipelines" {
+  repo_id   = "pipelines"
+  plugin_id = "yaml.config.plugin"
+
+  material {
+    type = "git"
+
+    attributes {
+      url    = "https://github.com/example/gocd-pipelines.git"
+      branch = "main"
+    }
+  }
+
+  configuration {
+    key   = "file_pattern"
+    value = "*.gocd.yaml"
+  }
+}
@@ -0,0 +1,14 @@
+resource "gocd_elastic_agent_profile" "alpine" {
+  profile_id         = "alpine"
+  cluster_profile_id = gocd_cluster_profile.docker.profile_id
+
+  properties {
+    key   = "Image"
+    value = "gocd/gocd-agent-alpine-3.12:v21.2.0"
+  }
+
+  properties {
+    key   = "MaxMemory"
+    value = "2G"
+  }
+}
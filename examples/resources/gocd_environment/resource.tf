@@ -1,5 +1,17 @@
 resource "gocd_environment" "test-environment" {
-  name = "test-environment"
+  name   = "test-environment"
+  agents = ["7b7bc2f4-8e4b-4b5e-a0a1-5b0d9c1e7f3a"]
+
+  environment_variables {
+    name  = "DEPLOY_TARGET"
+    value = "staging"
+  }
+
+  environment_variables {
+    name   = "DEPLOY_TOKEN"
+    value  = var.deploy_token
+    secure = true
+  }
 }
 
 resource "gocd_pipeline" "test-pipeline" {
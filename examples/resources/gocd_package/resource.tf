@@ -0,0 +1,23 @@
+resource "gocd_package" "openjdk" {
+  name    = "openjdk"
+  repo_id = gocd_package_repository.debian.repo_id
+
+  configuration {
+    key   = "PACKAGE_NAME"
+    value = "openjdk-11-jdk"
+  }
+}
+
+resource "gocd_pipeline" "java-image" {
+  name  = "java-image"
+  group = "images"
+
+  materials {
+    type = "package"
+    attributes {
+      ref = gocd_package.openjdk.package_id
+    }
+  }
+
+  stages = [data.gocd_stage_definition.build.json]
+}
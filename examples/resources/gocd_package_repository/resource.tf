@@ -0,0 +1,9 @@
+resource "gocd_package_repository" "debian" {
+  name      = "debian"
+  plugin_id = "deb"
+
+  configuration {
+    key   = "REPO_URL"
+    value = "http://deb.debian.org/debian"
+  }
+}
@@ -2,6 +2,7 @@ resource "gocd_pipeline" "test-pipeline3-upstream" {
   name           = "test-pipeline3-upstream"
   group          = "testing"
   label_template = "$${COUNT}"
+  start_paused   = true
 
   materials = [{
     type = "git"
@@ -19,6 +20,22 @@ resource "gocd_pipeline" "test-pipeline3" {
   name           = "test-pipeline3"
   group          = "testing"
   label_template = "$${COUNT}"
+  paused         = true
+  pause_reason   = "Waiting for test-pipeline3-upstream"
+
+  timer {
+    spec            = "0 0 22 ? * MON-FRI"
+    only_on_changes = true
+  }
+
+  tracking_tool {
+    type = "generic"
+
+    attributes {
+      url_pattern = "https://github.com/beamly/terraform-provider-gocd/issues/$${ID}"
+      regex       = "#(\\d+)"
+    }
+  }
 
   materials = [{
     type = "git"
@@ -29,7 +46,23 @@ resource "gocd_pipeline" "test-pipeline3" {
     }
   }]
 
-  stages = ["${data.gocd_stage_definition.test.json}"]
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        run_if  = ["passed"]
+        command = "echo"
+
+        arguments = [
+          "test",
+        ]
+      }
+    }
+  }
 }
 
 # CMD terraform import gocd_pipeline_stage.test "test"
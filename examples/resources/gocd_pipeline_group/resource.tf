@@ -0,0 +1,15 @@
+resource "gocd_pipeline_group" "deployments" {
+  name = "deployments"
+
+  view {
+    roles = ["developers"]
+  }
+
+  operate {
+    roles = ["release-managers"]
+  }
+
+  admins {
+    users = ["alice"]
+  }
+}
@@ -1,27 +1,44 @@
 resource "gocd_pipeline_template" "test-pipeline" {
-  name   = "template0-terraform"
-  stages = ["${data.gocd_stage_definition.test-stage.json}"]
-}
+  name = "template0-terraform"
 
-data "gocd_stage_definition" "test-stage" {
-  name = "test"
-  jobs = [
-    "${data.gocd_job_definition.test-job.json}"
-  ]
-}
+  stage {
+    name = "test"
 
-data "gocd_job_definition" "test-job" {
-  name = "test"
-  tasks = [
-    "${data.gocd_task_definition.test.json}"
-  ]
-}
-data "gocd_task_definition" "test" {
-  type    = "exec"
-  command = "echo"
-  arguments = [
-    "hello",
-    "world",
-  ]
-}
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+        arguments = [
+          "hello",
+          "world",
+        ]
+      }
+    }
+  }
 
+  stage {
+    name = "release"
+
+    approval {
+      type = "manual"
+
+      authorization {
+        roles = ["release-managers"]
+      }
+    }
+
+    job {
+      name = "release"
+
+      task {
+        type    = "exec"
+        command = "make"
+        arguments = [
+          "release",
+        ]
+      }
+    }
+  }
+}
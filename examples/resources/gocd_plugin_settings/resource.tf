@@ -0,0 +1,8 @@
+resource "gocd_plugin_settings" "yaml" {
+  plugin_id = "yaml.config.plugin"
+
+  configuration {
+    key   = "file_pattern"
+    value = "**/*.gocd.yaml"
+  }
+}
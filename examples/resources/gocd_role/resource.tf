@@ -0,0 +1,24 @@
+resource "gocd_role" "developers" {
+  name = "developers"
+  type = "gocd"
+  users = [
+    "alice",
+    "bob",
+  ]
+}
+
+resource "gocd_role" "ldap-admins" {
+  name           = "ldap-admins"
+  type           = "plugin"
+  auth_config_id = "ldap"
+
+  properties {
+    key   = "UserGroupMembershipAttribute"
+    value = "memberOf"
+  }
+
+  properties {
+    key   = "GroupIdentifiers"
+    value = "ou=admins,ou=groups,ou=system,dc=example,dc=com"
+  }
+}
@@ -0,0 +1,24 @@
+resource "gocd_scm" "app-pull-requests" {
+  name      = "app-pull-requests"
+  plugin_id = "github.pr"
+
+  configuration {
+    key   = "url"
+    value = "https://github.com/example/app.git"
+  }
+}
+
+resource "gocd_pipeline" "app-pull-requests" {
+  name  = "app-pull-requests"
+  group = "pull-requests"
+
+  materials {
+    type = "plugin"
+    attributes {
+      ref         = gocd_scm.app-pull-requests.scm_id
+      destination = "app"
+    }
+  }
+
+  stages = [data.gocd_stage_definition.test.json]
+}
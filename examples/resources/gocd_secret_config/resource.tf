@@ -0,0 +1,18 @@
+resource "gocd_secret_config" "deploy" {
+  secret_config_id = "deploy"
+  plugin_id        = "cd.go.secrets.file-based-plugin"
+  description      = "Credentials used by the deployment pipelines"
+
+  properties {
+    key   = "SecretsFilePath"
+    value = "/godata/config/deploy-secrets.json"
+  }
+
+  rules {
+    directive = "allow"
+    type      = "pipeline_group"
+    resource  = "deploy-*"
+  }
+}
+
+# Jobs in the `deploy-*` pipeline groups can now use `{{SECRET:[deploy][aws_secret_access_key]}}`.
@@ -0,0 +1,17 @@
+resource "gocd_server_configuration" "server" {
+  site_url               = "http://ci.example.com/go"
+  secure_site_url        = "https://ci.example.com/go"
+  purge_start_disk_space = 10
+  purge_upto_disk_space  = 20
+  job_timeout            = 60
+
+  mail_server {
+    hostname     = "smtp.example.com"
+    port         = 465
+    username     = "gocd"
+    password     = var.smtp_password
+    tls          = true
+    sender_email = "gocd@example.com"
+    admin_email  = "admin@example.com"
+  }
+}
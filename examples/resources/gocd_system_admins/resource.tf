@@ -0,0 +1,10 @@
+resource "gocd_role" "platform" {
+  name  = "platform"
+  type  = "gocd"
+  users = [gocd_user.jdoe.login_name]
+}
+
+resource "gocd_system_admins" "admins" {
+  users = ["admin"]
+  roles = [gocd_role.platform.name]
+}
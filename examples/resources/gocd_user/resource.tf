@@ -0,0 +1,12 @@
+resource "gocd_user" "jdoe" {
+  login_name      = "jdoe"
+  email           = "jdoe@example.com"
+  email_me        = true
+  checkin_aliases = ["jdoe", "jane.doe@example.com"]
+}
+
+# Offboarding a user is a matter of disabling or removing the resource.
+resource "gocd_user" "former-employee" {
+  login_name = "jsmith"
+  enabled    = false
+}
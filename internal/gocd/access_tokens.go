@@ -0,0 +1,119 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// AccessTokensService exposes calls for interacting with the personal access tokens of the current user, and with the
+// access tokens of every user for admins.
+type AccessTokensService service
+
+// AccessTokensListResponse describes the structure of the API response when listing access tokens
+type AccessTokensListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		AccessTokens []*AccessToken `json:"access_tokens"`
+	} `json:"_embedded,omitempty"`
+}
+
+// AccessToken describes a personal access token, which authenticates API requests as the user who created it. The
+// token itself is only returned when the access token is created.
+// codebeat:disable[TOO_MANY_IVARS]
+type AccessToken struct {
+	ID                        int       `json:"id,omitempty"`
+	Description               string    `json:"description"`
+	Username                  string    `json:"username,omitempty"`
+	Token                     string    `json:"token,omitempty"`
+	Revoked                   bool      `json:"revoked,omitempty"`
+	RevokeCause               string    `json:"revoke_cause,omitempty"`
+	RevokedBy                 string    `json:"revoked_by,omitempty"`
+	RevokedAt                 string    `json:"revoked_at,omitempty"`
+	RevokedBecauseUserDeleted bool      `json:"revoked_because_user_deleted,omitempty"`
+	CreatedAt                 string    `json:"created_at,omitempty"`
+	LastUsedAt                string    `json:"last_used_at,omitempty"`
+	Links                     *HALLinks `json:"_links,omitempty"`
+}
+
+// codebeat:enable[TOO_MANY_IVARS]
+
+// AccessTokenCreateRequest describes the request to create an access token
+type AccessTokenCreateRequest struct {
+	Description string `json:"description"`
+}
+
+// AccessTokenRevokeRequest describes the request to revoke an access token
+type AccessTokenRevokeRequest struct {
+	RevokeCause string `json:"revoke_cause,omitempty"`
+}
+
+// List the access tokens of the current user
+func (ats *AccessTokensService) List(ctx context.Context) (tokens []*AccessToken, resp *APIResponse, err error) {
+	return ats.list(ctx, "current_user/access_tokens")
+}
+
+// Get an access token of the current user by its id
+func (ats *AccessTokensService) Get(ctx context.Context, id int) (token *AccessToken, resp *APIResponse, err error) {
+	token = &AccessToken{}
+	_, resp, err = ats.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("current_user/access_tokens/%d", id),
+		APIVersion:   apiV1,
+		ResponseBody: token,
+	})
+
+	return
+}
+
+// Create an access token for the current user. The returned access token is the only one to include the token.
+func (ats *AccessTokensService) Create(ctx context.Context, description string) (token *AccessToken, resp *APIResponse, err error) {
+	token = &AccessToken{}
+	_, resp, err = ats.client.postAction(ctx, &APIClientRequest{
+		Path:         "current_user/access_tokens",
+		APIVersion:   apiV1,
+		RequestBody:  &AccessTokenCreateRequest{Description: description},
+		ResponseBody: token,
+	})
+
+	return
+}
+
+// Revoke an access token of the current user. Revoked access tokens can not be used again, and are not deleted.
+func (ats *AccessTokensService) Revoke(ctx context.Context, id int, cause string) (token *AccessToken, resp *APIResponse, err error) {
+	return ats.revoke(ctx, fmt.Sprintf("current_user/access_tokens/%d/revoke", id), cause)
+}
+
+// AdminList lists the access tokens of every user. Only admins can list them.
+func (ats *AccessTokensService) AdminList(ctx context.Context) (tokens []*AccessToken, resp *APIResponse, err error) {
+	return ats.list(ctx, "admin/access_tokens")
+}
+
+// AdminRevoke revokes an access token of any user. Only admins can revoke them.
+func (ats *AccessTokensService) AdminRevoke(ctx context.Context, id int, cause string) (token *AccessToken, resp *APIResponse, err error) {
+	return ats.revoke(ctx, fmt.Sprintf("admin/access_tokens/%d/revoke", id), cause)
+}
+
+func (ats *AccessTokensService) list(ctx context.Context, path string) (tokens []*AccessToken, resp *APIResponse, err error) {
+	r := &AccessTokensListResponse{}
+	_, resp, err = ats.client.getAction(ctx, &APIClientRequest{
+		Path:         path,
+		APIVersion:   apiV1,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.AccessTokens, resp, err
+}
+
+func (ats *AccessTokensService) revoke(ctx context.Context, path string, cause string) (token *AccessToken, resp *APIResponse, err error) {
+	token = &AccessToken{}
+	_, resp, err = ats.client.postAction(ctx, &APIClientRequest{
+		Path:         path,
+		APIVersion:   apiV1,
+		RequestBody:  &AccessTokenRevokeRequest{RevokeCause: cause},
+		ResponseBody: token,
+	})
+
+	return
+}
@@ -0,0 +1,160 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAccessTokensService(t *testing.T) {
+	t.Run("List", testAccessTokensServiceList)
+	t.Run("Get", testAccessTokensServiceGet)
+	t.Run("Create", testAccessTokensServiceCreate)
+	t.Run("Revoke", testAccessTokensServiceRevoke)
+	t.Run("AdminList", testAccessTokensServiceAdminList)
+	t.Run("AdminRevoke", testAccessTokensServiceAdminRevoke)
+	t.Run("BearerAuth", testAccessTokensServiceBearerAuth)
+}
+
+func testAccessTokensServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/current_user/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/access_tokens.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	tokens, _, err := client.AccessTokens.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, 42, tokens[0].ID)
+	assert.Equal(t, "Deployment bot", tokens[0].Description)
+	assert.Empty(t, tokens[0].Token)
+}
+
+func testAccessTokensServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/current_user/access_tokens/42", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/access_token.1.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	token, _, err := client.AccessTokens.Get(context.Background(), 42)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 42, token.ID)
+	assert.Equal(t, "deploy-bot", token.Username)
+	assert.True(t, token.Revoked)
+	assert.Equal(t, "Rotated", token.RevokeCause)
+	assert.Equal(t, "admin", token.RevokedBy)
+	assert.Equal(t, "2019-03-01T10:12:45Z", token.RevokedAt)
+	assert.Equal(t, "2019-02-28T18:03:11Z", token.LastUsedAt)
+	assert.Equal(t, "https://ci.example.com/go/api/current_user/access_tokens/42", token.Links.Get("Self").URL.String())
+}
+
+func testAccessTokensServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/current_user/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"description": "Deployment bot"}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/access_token.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	token, _, err := client.AccessTokens.Create(context.Background(), "Deployment bot")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 42, token.ID)
+	assert.Equal(t, "Deployment bot", token.Description)
+	assert.Equal(t, "2019-02-13T09:54:28Z", token.CreatedAt)
+	assert.Equal(t, "0f9a8b1f8fd4f2c1ad2b5a2f5c79f2d1", token.Token)
+}
+
+func testAccessTokensServiceRevoke(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/current_user/access_tokens/42/revoke", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"revoke_cause": "Rotated"}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/access_token.1.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	token, _, err := client.AccessTokens.Revoke(context.Background(), 42, "Rotated")
+
+	assert.Nil(t, err)
+	assert.True(t, token.Revoked)
+}
+
+func testAccessTokensServiceAdminList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/access_tokens.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	tokens, _, err := client.AccessTokens.AdminList(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, "deploy-bot", tokens[0].Username)
+}
+
+func testAccessTokensServiceAdminRevoke(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/access_tokens/42/revoke", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"revoke_cause": "Rotated"}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/access_token.1.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	token, _, err := client.AccessTokens.AdminRevoke(context.Background(), 42, "Rotated")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", token.RevokedBy)
+}
+
+func testAccessTokensServiceBearerAuth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/current_user/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer 0f9a8b1f8fd4f2c1ad2b5a2f5c79f2d1", r.Header.Get("Authorization"))
+		j, _ := ioutil.ReadFile("test/resources/access_tokens.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	c := NewClient(&Configuration{
+		Server: server.URL,
+		Token:  "0f9a8b1f8fd4f2c1ad2b5a2f5c79f2d1",
+	}, nil)
+	_, _, err := c.AccessTokens.List(context.Background())
+
+	assert.Nil(t, err)
+}
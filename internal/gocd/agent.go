@@ -75,6 +75,9 @@ func (s *AgentsService) List(ctx context.Context) (agents []*Agent, resp *APIRes
 		ResponseBody: &r,
 		APIVersion:   apiV4,
 	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
 
 	for _, agent := range r.Embedded.Agents {
 		agent.client = s.client
@@ -0,0 +1,85 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// ArtifactStoresService exposes calls for interacting with artifact stores, which configure the artifact plugins used
+// to publish and fetch external artifacts, eg a docker registry.
+type ArtifactStoresService service
+
+// ArtifactStoresListResponse describes the structure of the API response when listing artifact stores
+type ArtifactStoresListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		ArtifactStores []*ArtifactStore `json:"artifact_stores"`
+	} `json:"_embedded,omitempty"`
+}
+
+// ArtifactStore describes an artifact store
+type ArtifactStore struct {
+	ID         string                         `json:"id"`
+	PluginID   string                         `json:"plugin_id"`
+	Properties []*PluginConfigurationProperty `json:"properties,omitempty"`
+	Links      *HALLinks                      `json:"_links,omitempty"`
+	Version    string                         `json:"version,omitempty"`
+}
+
+// List all artifact stores
+func (ars *ArtifactStoresService) List(ctx context.Context) (stores []*ArtifactStore, resp *APIResponse, err error) {
+	r := &ArtifactStoresListResponse{}
+	_, resp, err = ars.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/artifact_stores",
+		APIVersion:   apiV1,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.ArtifactStores, resp, err
+}
+
+// Get an artifact store by id
+func (ars *ArtifactStoresService) Get(ctx context.Context, id string) (store *ArtifactStore, resp *APIResponse, err error) {
+	store = &ArtifactStore{}
+	_, resp, err = ars.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/artifact_stores/%s", id),
+		APIVersion:   apiV1,
+		ResponseBody: store,
+	})
+
+	return
+}
+
+// Create an artifact store
+func (ars *ArtifactStoresService) Create(ctx context.Context, st *ArtifactStore) (store *ArtifactStore, resp *APIResponse, err error) {
+	store = &ArtifactStore{}
+	_, resp, err = ars.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/artifact_stores",
+		APIVersion:   apiV1,
+		RequestBody:  st,
+		ResponseBody: store,
+	})
+
+	return
+}
+
+// Update an artifact store. The version of the artifact store must be set for the update to succeed.
+func (ars *ArtifactStoresService) Update(ctx context.Context, id string, st *ArtifactStore) (store *ArtifactStore, resp *APIResponse, err error) {
+	store = &ArtifactStore{}
+	_, resp, err = ars.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/artifact_stores/%s", id),
+		APIVersion:   apiV1,
+		RequestBody:  st,
+		ResponseBody: store,
+	})
+
+	return
+}
+
+// Delete an artifact store. Note: The artifact store must not be referenced by any external artifact.
+func (ars *ArtifactStoresService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return ars.client.deleteAction(ctx, fmt.Sprintf("admin/artifact_stores/%s", id), apiV1)
+}
@@ -0,0 +1,140 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestArtifactStoresService(t *testing.T) {
+	t.Run("List", testArtifactStoresServiceList)
+	t.Run("Get", testArtifactStoresServiceGet)
+	t.Run("Create", testArtifactStoresServiceCreate)
+	t.Run("Update", testArtifactStoresServiceUpdate)
+	t.Run("Delete", testArtifactStoresServiceDelete)
+}
+
+func testArtifactStoresServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/artifact_stores", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/artifactstores.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	stores, _, err := client.ArtifactStores.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, stores, 1)
+	testArtifactStore(t, stores[0])
+}
+
+func testArtifactStoresServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/artifact_stores/dockerhub", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/artifactstore.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	store, _, err := client.ArtifactStores.Get(context.Background(), "dockerhub")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", store.Version)
+	testArtifactStore(t, store)
+}
+
+func testArtifactStoresServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/artifact_stores", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "dockerhub",
+  "plugin_id": "cd.go.artifact.docker.registry",
+  "properties": [
+    {"key": "RegistryURL", "value": "https://index.docker.io/v1/"},
+    {"key": "Username", "value": "admin"},
+    {"key": "Password", "value": "secret"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/artifactstore.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	store, _, err := client.ArtifactStores.Create(context.Background(), &ArtifactStore{
+		ID:       "dockerhub",
+		PluginID: "cd.go.artifact.docker.registry",
+		Properties: []*PluginConfigurationProperty{
+			{Key: "RegistryURL", Value: "https://index.docker.io/v1/"},
+			{Key: "Username", Value: "admin"},
+			{Key: "Password", Value: "secret"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testArtifactStore(t, store)
+}
+
+func testArtifactStoresServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/artifact_stores/dockerhub", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/artifactstore.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	store, _, err := client.ArtifactStores.Update(context.Background(), "dockerhub", &ArtifactStore{
+		ID:       "dockerhub",
+		PluginID: "cd.go.artifact.docker.registry",
+		Version:  "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", store.Version)
+	testArtifactStore(t, store)
+}
+
+func testArtifactStoresServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/artifact_stores/dockerhub", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The artifact store 'dockerhub' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.ArtifactStores.Delete(context.Background(), "dockerhub")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The artifact store 'dockerhub' was deleted successfully.", message)
+}
+
+func testArtifactStore(t *testing.T, store *ArtifactStore) {
+	assert.Equal(t, "dockerhub", store.ID)
+	assert.Equal(t, "cd.go.artifact.docker.registry", store.PluginID)
+	assert.Len(t, store.Properties, 3)
+	assert.Equal(t, "https://index.docker.io/v1/", store.Properties[0].Value)
+	assert.Equal(t, "Password", store.Properties[2].Key)
+	assert.Equal(t, "AES:tdfTtYtIUSAF2JXJP/3YwA==:43Kjcs2hLfhRwzTVgBVbNQ==", store.Properties[2].EncryptedValue)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/artifact_stores/dockerhub", store.Links.Get("Self").URL.String())
+}
@@ -16,7 +16,9 @@ func (c *Client) Login(ctx context.Context) (err error) {
 
 	resp, err = c.Do(ctx, req, nil, responseTypeJSON)
 	if err == nil {
+		c.cookieMu.Lock()
 		c.cookie = resp.HTTP.Header["Set-Cookie"][0]
+		c.cookieMu.Unlock()
 	}
 	return
 }
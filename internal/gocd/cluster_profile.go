@@ -0,0 +1,85 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// ClusterProfilesService exposes calls for interacting with cluster profiles, which describe the cluster in which an
+// elastic agent plugin creates agents.
+type ClusterProfilesService service
+
+// ClusterProfilesListResponse describes the structure of the API response when listing cluster profiles
+type ClusterProfilesListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		Profiles []*ClusterProfile `json:"cluster_profiles"`
+	} `json:"_embedded,omitempty"`
+}
+
+// ClusterProfile describes a cluster profile
+type ClusterProfile struct {
+	ID         string                         `json:"id"`
+	PluginID   string                         `json:"plugin_id"`
+	Properties []*PluginConfigurationProperty `json:"properties,omitempty"`
+	Links      *HALLinks                      `json:"_links,omitempty"`
+	Version    string                         `json:"version,omitempty"`
+}
+
+// List all cluster profiles
+func (cps *ClusterProfilesService) List(ctx context.Context) (profiles []*ClusterProfile, resp *APIResponse, err error) {
+	r := &ClusterProfilesListResponse{}
+	_, resp, err = cps.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/elastic/cluster_profiles",
+		APIVersion:   apiV1,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.Profiles, resp, err
+}
+
+// Get a cluster profile by id
+func (cps *ClusterProfilesService) Get(ctx context.Context, id string) (profile *ClusterProfile, resp *APIResponse, err error) {
+	profile = &ClusterProfile{}
+	_, resp, err = cps.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/elastic/cluster_profiles/%s", id),
+		APIVersion:   apiV1,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Create a cluster profile
+func (cps *ClusterProfilesService) Create(ctx context.Context, cp *ClusterProfile) (profile *ClusterProfile, resp *APIResponse, err error) {
+	profile = &ClusterProfile{}
+	_, resp, err = cps.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/elastic/cluster_profiles",
+		APIVersion:   apiV1,
+		RequestBody:  cp,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Update a cluster profile. The version of the profile must be set for the update to succeed.
+func (cps *ClusterProfilesService) Update(ctx context.Context, id string, cp *ClusterProfile) (profile *ClusterProfile, resp *APIResponse, err error) {
+	profile = &ClusterProfile{}
+	_, resp, err = cps.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/elastic/cluster_profiles/%s", id),
+		APIVersion:   apiV1,
+		RequestBody:  cp,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Delete a cluster profile. Note: The cluster profile must not be referenced by any elastic agent profile.
+func (cps *ClusterProfilesService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return cps.client.deleteAction(ctx, fmt.Sprintf("admin/elastic/cluster_profiles/%s", id), apiV1)
+}
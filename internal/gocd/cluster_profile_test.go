@@ -0,0 +1,138 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestClusterProfilesService(t *testing.T) {
+	t.Run("List", testClusterProfilesServiceList)
+	t.Run("Get", testClusterProfilesServiceGet)
+	t.Run("Create", testClusterProfilesServiceCreate)
+	t.Run("Update", testClusterProfilesServiceUpdate)
+	t.Run("Delete", testClusterProfilesServiceDelete)
+}
+
+func testClusterProfilesServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/elastic/cluster_profiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/clusterprofiles.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	profiles, _, err := client.ClusterProfiles.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, profiles, 1)
+	testClusterProfile(t, profiles[0])
+}
+
+func testClusterProfilesServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/elastic/cluster_profiles/prod-cluster", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/clusterprofile.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ClusterProfiles.Get(context.Background(), "prod-cluster")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", profile.Version)
+	testClusterProfile(t, profile)
+}
+
+func testClusterProfilesServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/elastic/cluster_profiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "prod-cluster",
+  "plugin_id": "cd.go.contrib.elastic-agent.docker",
+  "properties": [
+    {"key": "GoServerUrl", "value": "https://ci.example.com/go"},
+    {"key": "DockerPassword", "value": "secret"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/clusterprofile.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ClusterProfiles.Create(context.Background(), &ClusterProfile{
+		ID:       "prod-cluster",
+		PluginID: "cd.go.contrib.elastic-agent.docker",
+		Properties: []*PluginConfigurationProperty{
+			{Key: "GoServerUrl", Value: "https://ci.example.com/go"},
+			{Key: "DockerPassword", Value: "secret"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testClusterProfile(t, profile)
+}
+
+func testClusterProfilesServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/elastic/cluster_profiles/prod-cluster", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/clusterprofile.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ClusterProfiles.Update(context.Background(), "prod-cluster", &ClusterProfile{
+		ID:       "prod-cluster",
+		PluginID: "cd.go.contrib.elastic-agent.docker",
+		Version:  "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", profile.Version)
+	testClusterProfile(t, profile)
+}
+
+func testClusterProfilesServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/elastic/cluster_profiles/prod-cluster", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The cluster profile 'prod-cluster' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.ClusterProfiles.Delete(context.Background(), "prod-cluster")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The cluster profile 'prod-cluster' was deleted successfully.", message)
+}
+
+func testClusterProfile(t *testing.T, profile *ClusterProfile) {
+	assert.Equal(t, "prod-cluster", profile.ID)
+	assert.Equal(t, "cd.go.contrib.elastic-agent.docker", profile.PluginID)
+	assert.Len(t, profile.Properties, 2)
+	assert.Equal(t, "https://ci.example.com/go", profile.Properties[0].Value)
+	assert.Equal(t, "DockerPassword", profile.Properties[1].Key)
+	assert.Equal(t, "AES:lzcCuNSe4vUx+CsWgN11Uw==:Ebq9tuxmWJgv2p3UEaXsYg==", profile.Properties[1].EncryptedValue)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/elastic/cluster_profiles/prod-cluster", profile.Links.Get("Self").URL.String())
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/user"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -19,6 +20,7 @@ const (
 	EnvVarServer         = "GOCD_SERVER"
 	EnvVarUsername       = "GOCD_USERNAME"
 	EnvVarPassword       = "GOCD_PASSWORD"
+	EnvVarToken          = "GOCD_TOKEN"
 	EnvVarSkipSsl        = "GOCD_SKIP_SSL_CHECK"
 )
 
@@ -27,7 +29,32 @@ type Configuration struct {
 	Server       string
 	Username     string `yaml:"username,omitempty"`
 	Password     string `yaml:"password,omitempty"`
+	Token        string `yaml:"token,omitempty"`
 	SkipSslCheck bool   `yaml:"skip_ssl_check,omitempty" survey:"skip_ssl_check"`
+
+	// CACertFile and CACertPEM are PEM encoded CA certificates trusted in addition to the system certificates.
+	CACertFile string `yaml:"ca_cert_file,omitempty"`
+	CACertPEM  string `yaml:"ca_cert_pem,omitempty"`
+	// ClientCert and ClientKey are the PEM encoded certificate and key presented to servers which require mTLS.
+	ClientCert string `yaml:"client_cert,omitempty"`
+	ClientKey  string `yaml:"client_key,omitempty"`
+	// ProxyURL is the proxy requests are sent through, instead of the one set in the environment.
+	ProxyURL string `yaml:"proxy_url,omitempty"`
+	// Timeout limits the time each request may take. There is no limit if it is 0.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+
+	// MaxConcurrentRequests limits the number of requests the client sends to the server at the same time. There is no
+	// limit if it is 0.
+	MaxConcurrentRequests int `yaml:"max_concurrent_requests,omitempty"`
+
+	// MaxRetries is the number of times a request which failed with a transient error is sent again. RetryMaxWait caps
+	// the wait between two attempts, and defaults to DefaultRetryMaxWait.
+	MaxRetries   int           `yaml:"max_retries,omitempty"`
+	RetryMaxWait time.Duration `yaml:"retry_max_wait,omitempty"`
+
+	// MaxConflictRetries is the number of times Client.UpdateWithConflictResolution sends an update again after the
+	// object was changed concurrently. Conflicts are not resolved if it is 0.
+	MaxConflictRetries int `yaml:"max_conflict_retries,omitempty"`
 }
 
 // LoadConfigByName loads configurations from yaml at the default file location
@@ -57,6 +84,10 @@ func LoadConfigByName(name string, cfg *Configuration) (err error) {
 		cfg.Password = password
 	}
 
+	if token := os.Getenv(EnvVarToken); token != "" {
+		cfg.Token = token
+	}
+
 	return nil
 }
 
@@ -83,4 +83,7 @@ func testConfigurationHasAuth(t *testing.T) {
 	c.Username = ""
 	c.Password = ""
 	assert.False(t, c.HasAuth())
+
+	c.Token = "token"
+	assert.True(t, c.HasAuth())
 }
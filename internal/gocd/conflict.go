@@ -0,0 +1,132 @@
+package gocd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Conflict describes a concurrent change of an object which was overwritten by UpdateWithConflictResolution.
+type Conflict struct {
+	Version string   // Version of the object which was changed concurrently
+	Fields  []string // Fields which were changed by the concurrent version
+}
+
+// ConflictError is returned by UpdateWithConflictResolution when the object was still changed concurrently after the
+// last attempt.
+type ConflictError struct {
+	Attempts  int
+	Conflicts []Conflict
+	Err       error
+}
+
+// Error lists the fields which were changed concurrently.
+func (e *ConflictError) Error() string {
+	fields := []string{}
+	for _, c := range e.Conflicts {
+		for _, f := range c.Fields {
+			if !containsString(fields, f) {
+				fields = append(fields, f)
+			}
+		}
+	}
+	sort.Strings(fields)
+	return fmt.Sprintf("object was changed concurrently during %d attempts, in fields [%s]: %s",
+		e.Attempts, strings.Join(fields, ", "), e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// UpdateWithConflictResolution calls update to send desired with its version. When GoCD rejects it because the object
+// was changed since that version was read, the current object is retrieved with fetch, its version is set on desired
+// and update is called again, at most MaxConflictRetries times. The error of update is returned as is if
+// MaxConflictRetries is 0.
+//
+// base is the object as it was read before the update. The fields in which each current object differs from the
+// previous one, starting with base, are returned as the concurrent changes which were overwritten, so that they can be
+// reported. base and the objects returned by fetch should be built alike, so that they do not differ on defaults.
+func (c *Client) UpdateWithConflictResolution(ctx context.Context, base, desired Versioned, fetch func(context.Context) (Versioned, error), update func(context.Context) error) ([]Conflict, error) {
+	conflicts := []Conflict{}
+
+	for attempt := 1; ; attempt++ {
+		err := update(ctx)
+		if err == nil || !IsPreconditionFailed(err) || c.params.MaxConflictRetries == 0 {
+			return conflicts, err
+		}
+		if attempt > c.params.MaxConflictRetries {
+			return conflicts, &ConflictError{Attempts: attempt, Conflicts: conflicts, Err: err}
+		}
+
+		current, fetchErr := fetch(ctx)
+		if fetchErr != nil {
+			return conflicts, fetchErr
+		}
+		conflicts = append(conflicts, Conflict{
+			Version: current.GetVersion(),
+			Fields:  changedFields(base, current),
+		})
+		base = current
+		desired.SetVersion(current.GetVersion())
+	}
+}
+
+// changedFields lists the top level JSON fields which differ between the previous and the current version of an
+// object. Missing and empty values are considered equal.
+func changedFields(previous, current interface{}) []string {
+	p, c := jsonFields(previous), jsonFields(current)
+
+	fields := []string{}
+	for k, v := range c {
+		if !reflect.DeepEqual(v, p[k]) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range p {
+		if _, ok := c[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func jsonFields(v interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	b, _ := json.Marshal(v)
+	json.Unmarshal(b, &fields)
+
+	for k, v := range fields {
+		switch k {
+		case "_links", "version", "template_version":
+			delete(fields, k)
+			continue
+		}
+		if v == nil || reflect.ValueOf(v).IsZero() || (isCollection(v) && reflect.ValueOf(v).Len() == 0) {
+			delete(fields, k)
+		}
+	}
+	return fields
+}
+
+func isCollection(v interface{}) bool {
+	switch v.(type) {
+	case []interface{}, map[string]interface{}:
+		return true
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
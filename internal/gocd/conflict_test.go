@@ -0,0 +1,151 @@
+package gocd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateWithConflictResolution(t *testing.T) {
+	t.Run("Resolved", testUpdateWithConflictResolutionResolved)
+	t.Run("Exhausted", testUpdateWithConflictResolutionExhausted)
+	t.Run("Disabled", testUpdateWithConflictResolutionDisabled)
+	t.Run("OtherError", testUpdateWithConflictResolutionOtherError)
+	t.Run("ConcurrentFields", testUpdateWithConflictResolutionConcurrentFields)
+	t.Run("ChangedFields", testChangedFields)
+}
+
+func preconditionFailed() error {
+	return &APIError{StatusCode: http.StatusPreconditionFailed, Status: "412 Precondition Failed"}
+}
+
+func testUpdateWithConflictResolutionResolved(t *testing.T) {
+	c := NewClient(&Configuration{MaxConflictRetries: 2}, nil)
+	base := &Role{Name: "admins", Type: "gocd", Version: "v1"}
+	desired := &Role{Name: "admins", Type: "gocd", Attributes: &RoleAttributesGoCD{Users: []string{"a"}}, Version: "v1"}
+
+	sentVersions := []string{}
+	conflicts, err := c.UpdateWithConflictResolution(context.Background(), base, desired,
+		func(ctx context.Context) (Versioned, error) {
+			return &Role{Name: "admins", Type: "plugin", Version: "v2"}, nil
+		},
+		func(ctx context.Context) error {
+			sentVersions = append(sentVersions, desired.Version)
+			if desired.Version == "v1" {
+				return preconditionFailed()
+			}
+			return nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, sentVersions)
+	assert.Equal(t, []Conflict{{Version: "v2", Fields: []string{"type"}}}, conflicts)
+}
+
+func testUpdateWithConflictResolutionExhausted(t *testing.T) {
+	c := NewClient(&Configuration{MaxConflictRetries: 2}, nil)
+	desired := &Role{Name: "admins", Version: "v1"}
+
+	calls := 0
+	conflicts, err := c.UpdateWithConflictResolution(context.Background(), &Role{Name: "admins", Version: "v1"}, desired,
+		func(ctx context.Context) (Versioned, error) {
+			return &Role{Name: "admins", Attributes: &RoleAttributesGoCD{Users: []string{"someone"}}, Version: "v2"}, nil
+		},
+		func(ctx context.Context) error {
+			calls++
+			return preconditionFailed()
+		})
+
+	assert.Equal(t, 3, calls)
+	// The second fetch returns the same version, which has no new concurrent change.
+	assert.Equal(t, []Conflict{{Version: "v2", Fields: []string{"attributes"}}, {Version: "v2", Fields: []string{}}}, conflicts)
+	conflictErr, ok := err.(*ConflictError)
+	assert.True(t, ok)
+	assert.Equal(t, 3, conflictErr.Attempts)
+	assert.True(t, IsPreconditionFailed(err))
+	assert.Contains(t, err.Error(), "in fields [attributes]")
+}
+
+func testUpdateWithConflictResolutionDisabled(t *testing.T) {
+	c := NewClient(&Configuration{}, nil)
+	desired := &Role{Name: "admins", Version: "v1"}
+
+	calls := 0
+	_, err := c.UpdateWithConflictResolution(context.Background(), desired, desired,
+		func(ctx context.Context) (Versioned, error) {
+			t.Fatal("fetch should not be called")
+			return nil, nil
+		},
+		func(ctx context.Context) error {
+			calls++
+			return preconditionFailed()
+		})
+
+	assert.Equal(t, 1, calls)
+	_, isAPIError := err.(*APIError)
+	assert.True(t, isAPIError)
+}
+
+func testUpdateWithConflictResolutionOtherError(t *testing.T) {
+	c := NewClient(&Configuration{MaxConflictRetries: 2}, nil)
+
+	calls := 0
+	_, err := c.UpdateWithConflictResolution(context.Background(), &Role{}, &Role{}, nil,
+		func(ctx context.Context) error {
+			calls++
+			return errors.New("boom")
+		})
+
+	assert.Equal(t, 1, calls)
+	assert.EqualError(t, err, "boom")
+}
+
+// testUpdateWithConflictResolutionConcurrentFields only reports the field changed concurrently, not the fields changed
+// by the update, nor the fields filled in by GoCD which the update does not send.
+func testUpdateWithConflictResolutionConcurrentFields(t *testing.T) {
+	c := NewClient(&Configuration{MaxConflictRetries: 1}, nil)
+	origin := &PipelineConfigOrigin{Type: "gocd"}
+	existing := &Pipeline{
+		Name:          "p1",
+		LabelTemplate: "${COUNT}",
+		LockBehavior:  "none",
+		Origin:        origin,
+		Version:       "v1",
+	}
+	desired := &Pipeline{
+		Name:                 "p1",
+		EnvironmentVariables: []*EnvironmentVariable{{Name: "ENV", Value: "prod"}},
+		Version:              "v1",
+	}
+
+	conflicts, err := c.UpdateWithConflictResolution(context.Background(), existing, desired,
+		func(ctx context.Context) (Versioned, error) {
+			return &Pipeline{
+				Name:          "p1",
+				LabelTemplate: "1.${COUNT}",
+				LockBehavior:  "none",
+				Origin:        origin,
+				Version:       "v2",
+			}, nil
+		},
+		func(ctx context.Context) error {
+			if desired.Version == "v1" {
+				return preconditionFailed()
+			}
+			return nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Conflict{{Version: "v2", Fields: []string{"label_template"}}}, conflicts)
+}
+
+func testChangedFields(t *testing.T) {
+	previous := &Role{Name: "admins", Type: "gocd", Attributes: &RoleAttributesGoCD{Users: []string{"a"}}, Version: "v1"}
+	current := &Role{Name: "admins", Type: "plugin", Attributes: &RoleAttributesGoCD{}, Version: "v2"}
+
+	assert.Equal(t, []string{"attributes", "type"}, changedFields(previous, current))
+	assert.Equal(t, []string{}, changedFields(previous, previous))
+}
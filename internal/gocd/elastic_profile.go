@@ -0,0 +1,85 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// ElasticProfilesService exposes calls for interacting with elastic agent profiles, which describe the elastic agents
+// a job may be assigned to.
+type ElasticProfilesService service
+
+// ElasticProfilesListResponse describes the structure of the API response when listing elastic agent profiles
+type ElasticProfilesListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		Profiles []*ElasticProfile `json:"profiles"`
+	} `json:"_embedded,omitempty"`
+}
+
+// ElasticProfile describes an elastic agent profile
+type ElasticProfile struct {
+	ID               string                         `json:"id"`
+	ClusterProfileID string                         `json:"cluster_profile_id"`
+	Properties       []*PluginConfigurationProperty `json:"properties,omitempty"`
+	Links            *HALLinks                      `json:"_links,omitempty"`
+	Version          string                         `json:"version,omitempty"`
+}
+
+// List all elastic agent profiles
+func (eps *ElasticProfilesService) List(ctx context.Context) (profiles []*ElasticProfile, resp *APIResponse, err error) {
+	r := &ElasticProfilesListResponse{}
+	_, resp, err = eps.client.getAction(ctx, &APIClientRequest{
+		Path:         "elastic/profiles",
+		APIVersion:   apiV2,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.Profiles, resp, err
+}
+
+// Get an elastic agent profile by id
+func (eps *ElasticProfilesService) Get(ctx context.Context, id string) (profile *ElasticProfile, resp *APIResponse, err error) {
+	profile = &ElasticProfile{}
+	_, resp, err = eps.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("elastic/profiles/%s", id),
+		APIVersion:   apiV2,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Create an elastic agent profile
+func (eps *ElasticProfilesService) Create(ctx context.Context, ep *ElasticProfile) (profile *ElasticProfile, resp *APIResponse, err error) {
+	profile = &ElasticProfile{}
+	_, resp, err = eps.client.postAction(ctx, &APIClientRequest{
+		Path:         "elastic/profiles",
+		APIVersion:   apiV2,
+		RequestBody:  ep,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Update an elastic agent profile. The version of the profile must be set for the update to succeed.
+func (eps *ElasticProfilesService) Update(ctx context.Context, id string, ep *ElasticProfile) (profile *ElasticProfile, resp *APIResponse, err error) {
+	profile = &ElasticProfile{}
+	_, resp, err = eps.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("elastic/profiles/%s", id),
+		APIVersion:   apiV2,
+		RequestBody:  ep,
+		ResponseBody: profile,
+	})
+
+	return
+}
+
+// Delete an elastic agent profile
+func (eps *ElasticProfilesService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return eps.client.deleteAction(ctx, fmt.Sprintf("elastic/profiles/%s", id), apiV2)
+}
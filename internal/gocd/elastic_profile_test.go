@@ -0,0 +1,135 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestElasticProfilesService(t *testing.T) {
+	t.Run("List", testElasticProfilesServiceList)
+	t.Run("Get", testElasticProfilesServiceGet)
+	t.Run("Create", testElasticProfilesServiceCreate)
+	t.Run("Update", testElasticProfilesServiceUpdate)
+	t.Run("Delete", testElasticProfilesServiceDelete)
+}
+
+func testElasticProfilesServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/elastic/profiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/elasticprofiles.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	profiles, _, err := client.ElasticProfiles.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, profiles, 1)
+	testElasticProfile(t, profiles[0])
+}
+
+func testElasticProfilesServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/elastic/profiles/unit-tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/elasticprofile.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ElasticProfiles.Get(context.Background(), "unit-tests")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", profile.Version)
+	testElasticProfile(t, profile)
+}
+
+func testElasticProfilesServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/elastic/profiles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "unit-tests",
+  "cluster_profile_id": "prod-cluster",
+  "properties": [
+    {"key": "Image", "value": "gocdcontrib/gocd-dev-build"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/elasticprofile.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ElasticProfiles.Create(context.Background(), &ElasticProfile{
+		ID:               "unit-tests",
+		ClusterProfileID: "prod-cluster",
+		Properties: []*PluginConfigurationProperty{
+			{Key: "Image", Value: "gocdcontrib/gocd-dev-build"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testElasticProfile(t, profile)
+}
+
+func testElasticProfilesServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/elastic/profiles/unit-tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/elasticprofile.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	profile, _, err := client.ElasticProfiles.Update(context.Background(), "unit-tests", &ElasticProfile{
+		ID:               "unit-tests",
+		ClusterProfileID: "prod-cluster",
+		Version:          "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", profile.Version)
+	testElasticProfile(t, profile)
+}
+
+func testElasticProfilesServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/elastic/profiles/unit-tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The elastic agent profile 'unit-tests' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.ElasticProfiles.Delete(context.Background(), "unit-tests")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The elastic agent profile 'unit-tests' was deleted successfully.", message)
+}
+
+func testElasticProfile(t *testing.T, profile *ElasticProfile) {
+	assert.Equal(t, "unit-tests", profile.ID)
+	assert.Equal(t, "prod-cluster", profile.ClusterProfileID)
+	assert.Len(t, profile.Properties, 2)
+	assert.Equal(t, "Image", profile.Properties[0].Key)
+	assert.Equal(t, "gocdcontrib/gocd-dev-build", profile.Properties[0].Value)
+	assert.Equal(t, "https://ci.example.com/go/api/elastic/profiles/unit-tests", profile.Links.Get("Self").URL.String())
+}
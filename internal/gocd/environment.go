@@ -117,6 +117,10 @@ func (es *EnvironmentsService) Patch(ctx context.Context, name string, patch *En
 		return nil, nil, err
 	}
 
+	// Concurrent patches of the same environment are serialized, as GoCD may reject them as conflicting.
+	es.client.Lock("environments/" + name)
+	defer es.client.Unlock("environments/" + name)
+
 	e = &Environment{}
 	_, resp, err = es.client.patchAction(ctx, &APIClientRequest{
 		Path:         "admin/environments/" + name,
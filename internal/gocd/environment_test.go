@@ -52,7 +52,7 @@ func testEnvironmentIntegration(t *testing.T) {
 		Stages: buildUpstreamPipelineStages(),
 	}
 
-	_, _, err = intClient.PipelineConfigs.Create(ctx, "test-group", p)
+	_, _, err = intClient.PipelineConfigs.Create(ctx, "test-group", p, nil)
 	if err != nil {
 		t.Error(err)
 	}
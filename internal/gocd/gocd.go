@@ -3,7 +3,6 @@ package gocd
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
@@ -15,6 +14,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -83,28 +83,48 @@ type APIRequest struct {
 
 // Client struct which acts as an interface to the GoCD Server. Exposes resource service handlers.
 type Client struct {
-	clientMu sync.Mutex // clientMu protects the client during multi-threaded calls
-	client   *http.Client
+	client *http.Client
+
+	requests chan struct{}          // requests bounds the number of requests in flight, if a limit is configured
+	locksMu  sync.Mutex             // locksMu protects locks
+	locks    map[string]*sync.Mutex // locks serializes the callers of Lock which share a key
+	cookieMu sync.RWMutex           // cookieMu protects cookie
 
 	params *ClientParameters
 
 	Log *logrus.Logger
 
-	Agents            *AgentsService
-	PipelineGroups    *PipelineGroupsService
-	Stages            *StagesService
-	Jobs              *JobsService
-	PipelineTemplates *PipelineTemplatesService
-	Pipelines         *PipelinesService
-	PipelineConfigs   *PipelineConfigsService
-	Configuration     *ConfigurationService
-	ConfigRepos       *ConfigRepoService
-	Encryption        *EncryptionService
-	Plugins           *PluginsService
-	Environments      *EnvironmentsService
-	Properties        *PropertiesService
-	Roles             *RoleService
-	ServerVersion     *ServerVersionService
+	// RetryPolicy decides which failed requests are sent again. Requests are only sent once if it is nil.
+	RetryPolicy RetryPolicy
+
+	Agents              *AgentsService
+	PipelineGroups      *PipelineGroupsService
+	Stages              *StagesService
+	Jobs                *JobsService
+	PipelineTemplates   *PipelineTemplatesService
+	Pipelines           *PipelinesService
+	PipelineConfigs     *PipelineConfigsService
+	Configuration       *ConfigurationService
+	ConfigRepos         *ConfigRepoService
+	Encryption          *EncryptionService
+	Plugins             *PluginsService
+	Environments        *EnvironmentsService
+	Properties          *PropertiesService
+	Roles               *RoleService
+	ServerVersion       *ServerVersionService
+	ElasticProfiles     *ElasticProfilesService
+	ClusterProfiles     *ClusterProfilesService
+	SecurityAuthConfigs *SecurityAuthConfigsService
+	SecretConfigs       *SecretConfigsService
+	ArtifactStores      *ArtifactStoresService
+	PackageRepositories *PackageRepositoriesService
+	Packages            *PackagesService
+	SCMs                *SCMsService
+	PluginSettings      *PluginSettingsService
+	ServerConfiguration *ServerConfigurationService
+	Users               *UsersService
+	SystemAdmins        *SystemAdminsService
+	AccessTokens        *AccessTokensService
 
 	common service
 	cookie string
@@ -115,8 +135,11 @@ type ClientParameters struct {
 	BaseURL  *url.URL
 	Username string
 	Password string
+	Token    string // Token is a personal access token, which is used instead of the username and password if it is set
 
 	UserAgent string
+
+	MaxConflictRetries int
 }
 
 // BuildPath creates an absolute URL from ClientParameters and a relative URL
@@ -148,9 +171,9 @@ type Auth struct {
 	Password string
 }
 
-// HasAuth checks whether or not we have the required Username/Password variables provided.
+// HasAuth checks whether or not we have the required Username/Password variables, or a Token, provided.
 func (c *Configuration) HasAuth() bool {
-	return (c.Username != "") && (c.Password != "")
+	return (c.Username != "") && (c.Password != "") || c.Token != ""
 }
 
 // Client returns a client which allows us to interact with the GoCD Server.
@@ -173,8 +196,23 @@ func NewClient(cfg *Configuration, httpClient *http.Client) *Client {
 			UserAgent: userAgent,
 			Username:  cfg.Username,
 			Password:  cfg.Password,
+			Token:     cfg.Token,
+
+			MaxConflictRetries: cfg.MaxConflictRetries,
 		},
-		Log: logrus.New(),
+		Log:   logrus.New(),
+		locks: map[string]*sync.Mutex{},
+	}
+
+	if cfg.MaxRetries > 0 {
+		c.RetryPolicy = &BackoffRetryPolicy{
+			MaxRetries: cfg.MaxRetries,
+			MaxWait:    cfg.RetryMaxWait,
+		}
+	}
+
+	if cfg.MaxConcurrentRequests > 0 {
+		c.requests = make(chan struct{}, cfg.MaxConcurrentRequests)
 	}
 
 	c.common.client = c
@@ -187,14 +225,14 @@ func NewClient(cfg *Configuration, httpClient *http.Client) *Client {
 	return c
 }
 
-// generateHTTPClient taking into account ssl, and existing httpClient
+// generateHTTPClient taking into account ssl, proxy and timeout settings, and existing httpClient
 func generateHTTPClient(cfg *Configuration, httpClient *http.Client) *http.Client {
 	if httpClient == nil {
-		httpClient = http.DefaultClient
-		if strings.HasPrefix(cfg.Server, "https") && cfg.SkipSslCheck {
-			httpClient.Transport = &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.SkipSslCheck},
-			}
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+		if transport, err := cfg.HTTPTransport(); err != nil {
+			httpClient.Transport = &errorTransport{err: err}
+		} else {
+			httpClient.Transport = transport
 		}
 	}
 	return httpClient
@@ -218,6 +256,19 @@ func attachServices(c *Client) {
 	c.Properties = (*PropertiesService)(&c.common)
 	c.Roles = (*RoleService)(&c.common)
 	c.ServerVersion = (*ServerVersionService)(&c.common)
+	c.ElasticProfiles = (*ElasticProfilesService)(&c.common)
+	c.ClusterProfiles = (*ClusterProfilesService)(&c.common)
+	c.SecurityAuthConfigs = (*SecurityAuthConfigsService)(&c.common)
+	c.SecretConfigs = (*SecretConfigsService)(&c.common)
+	c.ArtifactStores = (*ArtifactStoresService)(&c.common)
+	c.PackageRepositories = (*PackageRepositoriesService)(&c.common)
+	c.Packages = (*PackagesService)(&c.common)
+	c.SCMs = (*SCMsService)(&c.common)
+	c.PluginSettings = (*PluginSettingsService)(&c.common)
+	c.ServerConfiguration = (*ServerConfigurationService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
+	c.SystemAdmins = (*SystemAdminsService)(&c.common)
+	c.AccessTokens = (*AccessTokensService)(&c.common)
 }
 
 // codebeat:enable[ABC]
@@ -227,14 +278,27 @@ func (c *Client) BaseURL() *url.URL {
 	return c.params.BaseURL
 }
 
-// Lock the client until release
-func (c *Client) Lock() {
-	c.clientMu.Lock()
+// Lock the given key of the client until it is released with Unlock. Requests are not serialized by the client, so
+// this is only needed around read-modify-write sequences on the same GoCD object, such as a pipeline or an environment.
+func (c *Client) Lock(key string) {
+	c.locksMu.Lock()
+	l, ok := c.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		c.locks[key] = l
+	}
+	c.locksMu.Unlock()
+
+	l.Lock()
 }
 
-// Unlock the client after a lock action
-func (c *Client) Unlock() {
-	c.clientMu.Unlock()
+// Unlock the given key of the client after a lock action
+func (c *Client) Unlock(key string) {
+	c.locksMu.Lock()
+	l := c.locks[key]
+	c.locksMu.Unlock()
+
+	l.Unlock()
 }
 
 // NewRequest creates an HTTP requests to the GoCD API endpoints.
@@ -292,23 +356,80 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, apiVersion
 	}
 	req.HTTP.Header.Set("User-Agent", c.params.UserAgent)
 
-	if c.cookie == "" {
+	c.cookieMu.RLock()
+	cookie := c.cookie
+	c.cookieMu.RUnlock()
+
+	if c.params.Token != "" {
+		req.HTTP.Header.Set("Authorization", "Bearer "+c.params.Token)
+	} else if cookie == "" {
 		if c.params.Username != "" && c.params.Password != "" {
 			req.HTTP.SetBasicAuth(c.params.Username, c.params.Password)
 		}
 	} else {
-		req.HTTP.Header.Set("Cookie", c.cookie)
+		req.HTTP.Header.Set("Cookie", cookie)
 	}
 
 	return
 }
 
-// Do takes an HTTP request and resposne the response from the GoCD API endpoint.
+// Do takes an HTTP request and resposne the response from the GoCD API endpoint. Failed requests are sent again as
+// long as the RetryPolicy of the client allows it.
 func (c *Client) Do(ctx context.Context, req *APIRequest, v interface{}, responseType string) (*APIResponse, error) {
+	req.HTTP = req.HTTP.WithContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		r, err := c.do(ctx, req, v, responseType)
+		if err == nil || c.RetryPolicy == nil {
+			return r, err
+		}
+
+		var resp *http.Response
+		if r != nil {
+			resp = r.HTTP
+		}
+		wait, retry := c.RetryPolicy.Retry(attempt, req.HTTP, resp, err)
+		if !retry || (req.HTTP.Body != nil && req.HTTP.GetBody == nil) {
+			return r, err
+		}
+		if resp != nil && v == nil {
+			resp.Body.Close()
+		}
+
+		c.Log.WithFields(logrus.Fields{
+			"Attempt": attempt,
+			"Wait":    wait,
+		}).Debugf("Retrying request after error: %s", err)
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return r, err
+		}
+
+		if req.HTTP.GetBody != nil {
+			if req.HTTP.Body, err = req.HTTP.GetBody(); err != nil {
+				return r, err
+			}
+		}
+	}
+}
+
+// do sends a single attempt of an HTTP request.
+func (c *Client) do(ctx context.Context, req *APIRequest, v interface{}, responseType string) (*APIResponse, error) {
 	var err error
 	var resp *http.Response
 
-	req.HTTP = req.HTTP.WithContext(ctx)
+	// Wait for a free slot if the number of requests in flight is limited. The slot is released when this attempt
+	// returns, so a body which is left to the caller to read, when v is nil, is read outside of the limit.
+	if c.requests != nil {
+		select {
+		case c.requests <- struct{}{}:
+			defer func() { <-c.requests }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 
 	if resp, err = c.client.Do(req.HTTP); err != nil {
 		return nil, err
@@ -319,17 +440,28 @@ func (c *Client) Do(ctx context.Context, req *APIRequest, v interface{}, respons
 		HTTP:    resp,
 	}
 
-	if v != nil {
-		if r.Body, err = readDoResponseBody(v, &r.HTTP.Body, responseType); err != nil {
-			return nil, err
+	// Error responses, such as an empty 404 or the HTML page of a proxy, are always returned as an *APIError with the
+	// raw body. Some GoCD errors describe the failure with the usual response object, so v is still decoded when the
+	// body allows it, and the body can still be read from the response.
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if _, isWriter := v.(io.Writer); v != nil && !isWriter {
+			resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+			readDoResponseBody(v, &resp.Body, responseType)
 		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		r.Body = string(b)
+		return r, CheckResponse(r)
 	}
 
-	if err = CheckResponse(r); err != nil {
-		return r, err
+	if v != nil {
+		if r.Body, err = readDoResponseBody(v, &r.HTTP.Body, responseType); err != nil {
+			return r, err
+		}
 	}
 
-	return r, err
+	return r, nil
 }
 
 // getAPIVersion is a wrapper around ServerVersion.GetAPIVersion that starts by making sure ServerVersionService.Get has
@@ -369,22 +501,105 @@ func readDoResponseBody(v interface{}, bodyReader *io.ReadCloser, responseType s
 
 }
 
-// CheckResponse asserts that the http response status code was 2xx.
+// APIError is returned when the GoCD server responds to a request with an error status. The GoCD message and field
+// errors are extracted from the response body when it has them.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	Path       string
+	Message    string
+	Errors     map[string][]string // Errors lists the messages for each invalid field, from `data.errors`
+	Body       string              // Body is the raw body of the response, which is not always JSON
+
+	details string
+}
+
+// Error returns the HTTP status followed by the GoCD message and field errors, if any.
+func (e *APIError) Error() string {
+	errorParts := []string{
+		fmt.Sprintf("Received HTTP Status '%s'", e.Status),
+	}
+	if e.details != "" {
+		errorParts = append(errorParts, e.details)
+	}
+	return strings.Join(errorParts, ": ")
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError for a conflicting change.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsPreconditionFailed reports whether err is an APIError for a change made against an outdated version (ETag).
+func IsPreconditionFailed(err error) bool {
+	return hasStatusCode(err, http.StatusPreconditionFailed)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == code
+}
+
+// CheckResponse asserts that the http response status code was 2xx. Other statuses are returned as an *APIError.
 func CheckResponse(response *APIResponse) (err error) {
 	if response.HTTP.StatusCode < 200 || response.HTTP.StatusCode >= 400 {
+		apiErr := &APIError{
+			StatusCode: response.HTTP.StatusCode,
+			Status:     response.HTTP.Status,
+			Body:       response.Body,
+			details:    createErrorResponseMessage(response.Body),
+		}
+		apiErr.Message, apiErr.Errors = parseErrorResponse(response.Body)
 
-		errorParts := []string{
-			fmt.Sprintf("Received HTTP Status '%s'", response.HTTP.Status),
+		req := response.HTTP.Request
+		if response.Request != nil && response.Request.HTTP != nil {
+			req = response.Request.HTTP
 		}
-		if message := createErrorResponseMessage(response.Body); message != "" {
-			errorParts = append(errorParts, message)
+		if req != nil {
+			apiErr.Method = req.Method
+			apiErr.Path = req.URL.Path
 		}
 
-		err = errors.New(strings.Join(errorParts, ": "))
+		err = apiErr
 	}
 	return
 }
 
+// parseErrorResponse extracts the message and field errors from the body of a GoCD error response.
+func parseErrorResponse(body string) (message string, fieldErrors map[string][]string) {
+	errorBody := struct {
+		Message string `json:"message"`
+		Data    struct {
+			Errors map[string]interface{} `json:"errors"`
+		} `json:"data"`
+	}{}
+
+	json.Unmarshal([]byte(body), &errorBody)
+
+	for field, raw := range errorBody.Data.Errors {
+		messages, isList := raw.([]interface{})
+		if !isList {
+			continue
+		}
+		for _, m := range messages {
+			if m, isString := m.(string); isString {
+				if fieldErrors == nil {
+					fieldErrors = map[string][]string{}
+				}
+				fieldErrors[field] = append(fieldErrors[field], m)
+			}
+		}
+	}
+
+	return errorBody.Message, fieldErrors
+}
+
 func createErrorResponseMessage(body string) (resp string) {
 	reqBody := make(map[string]interface{})
 	resBody := make(map[string]interface{})
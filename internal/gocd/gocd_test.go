@@ -7,11 +7,15 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 const (
@@ -97,6 +101,86 @@ func TestClient(t *testing.T) {
 	t.Run("NewHTTPS", testClientNewHTTPS)
 	t.Run("TestDo", testClientDo)
 	t.Run("New", testNewClient)
+	t.Run("MaxConcurrentRequests", testClientMaxConcurrentRequests)
+	t.Run("MaxConcurrentRequestsCancel", testClientMaxConcurrentRequestsCancel)
+	t.Run("Lock", testClientLock)
+}
+
+func testClientMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/api/concurrent", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		fmt.Fprint(w, "{}")
+	})
+
+	c := NewClient(&Configuration{
+		Server:                server.URL,
+		MaxConcurrentRequests: 2,
+	}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := c.NewRequest("GET", "concurrent", nil, "")
+			assert.NoError(t, err)
+			_, err = c.Do(context.Background(), req, &map[string]interface{}{}, responseTypeJSON)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight)
+}
+
+func testClientMaxConcurrentRequestsCancel(t *testing.T) {
+	c := NewClient(&Configuration{
+		Server:                server.URL,
+		MaxConcurrentRequests: 1,
+	}, nil)
+	c.requests <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := c.NewRequest("GET", "concurrent", nil, "")
+	assert.NoError(t, err)
+	_, err = c.Do(ctx, req, nil, responseTypeJSON)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func testClientLock(t *testing.T) {
+	c := NewClient(&Configuration{Server: server.URL}, nil)
+
+	c.Lock("a")
+	// A different key is not blocked by the lock on "a".
+	c.Lock("b")
+	c.Unlock("b")
+
+	locked := make(chan struct{})
+	go func() {
+		c.Lock("a")
+		close(locked)
+		c.Unlock("a")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected the second lock on the same key to wait")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	c.Unlock("a")
+	<-locked
 }
 
 func testClientNewHTTPS(t *testing.T) {
@@ -109,15 +193,19 @@ func testClientNewHTTPS(t *testing.T) {
 	transport := c.client.Transport.(*http.Transport)
 	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
 
-	client.Lock()
-	client.Unlock()
+	client.Lock("key")
+	client.Unlock("key")
 }
 
 func TestCheckResponse(t *testing.T) {
 	t.Run("ValidHTTP", testCheckResponseValid)
 	t.Run("FailHTTP", testCheckResponseInvalid)
 	t.Run("FailBodyRead", testCheckResponseFailBodyRead)
+	t.Run("APIError", testCheckResponseAPIError)
+	t.Run("DoEmptyNotFound", testCheckResponseDoEmptyNotFound)
+	t.Run("DoHTMLNotFound", testCheckResponseDoHTMLNotFound)
 	t.Run("NewRequestWithCookie", testNewRequestWithCookie)
+	t.Run("NewRequestWithToken", testNewRequestWithToken)
 	t.Run("NewRequestFailBodyDecode", testNewRequestFailDecode)
 	t.Run("NewRequestFailBadMethod", testNewRequestFailBadMethod)
 }
@@ -145,6 +233,22 @@ func testNewRequestWithCookie(t *testing.T) {
 	assert.Equal(t, mockCookie, string(cookie))
 }
 
+func testNewRequestWithToken(t *testing.T) {
+	c := Client{
+		params: &ClientParameters{
+			BaseURL:  &url.URL{},
+			Username: "mockUsername",
+			Password: "mockPassword",
+			Token:    "MockToken",
+		},
+		cookie: "MockCookie",
+	}
+	r, err := c.NewRequest("GET", "mock", nil, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer MockToken", r.HTTP.Header.Get("Authorization"))
+	assert.Empty(t, r.HTTP.Header.Get("Cookie"))
+}
+
 func testNewRequestFailBadMethod(t *testing.T) {
 	c := Client{
 		params: &ClientParameters{
@@ -208,6 +312,89 @@ func testCheckResponseInvalid(t *testing.T) {
 
 }
 
+func testCheckResponseAPIError(t *testing.T) {
+	req, _ := http.NewRequest("PUT", "https://gocd.example.com/go/api/admin/pipelines/p1", nil)
+	err := CheckResponse(&APIResponse{
+		HTTP: &http.Response{
+			StatusCode: 422,
+			Status:     "422 Unprocessable Entity",
+			Request:    req,
+		},
+		Body: `{"message":"Validations failed for pipeline 'p1'.","data":{"name":"p1","errors":{"label_template":["Invalid label."],"materials":{"url":["x"]}}}}`,
+	})
+
+	apiErr, ok := err.(*APIError)
+	assert.True(t, ok)
+	assert.Equal(t, 422, apiErr.StatusCode)
+	assert.Equal(t, "PUT", apiErr.Method)
+	assert.Equal(t, "/go/api/admin/pipelines/p1", apiErr.Path)
+	assert.Equal(t, "Validations failed for pipeline 'p1'.", apiErr.Message)
+	assert.Equal(t, map[string][]string{"label_template": {"Invalid label."}}, apiErr.Errors)
+	assert.Contains(t, apiErr.Error(), "Received HTTP Status '422 Unprocessable Entity': {")
+	assert.False(t, IsNotFound(err))
+
+	for _, test := range []struct {
+		code  int
+		check func(error) bool
+	}{
+		{code: 404, check: IsNotFound},
+		{code: 409, check: IsConflict},
+		{code: 412, check: IsPreconditionFailed},
+	} {
+		err := CheckResponse(&APIResponse{HTTP: &http.Response{StatusCode: test.code}})
+		assert.True(t, test.check(err))
+		assert.True(t, test.check(fmt.Errorf("wrapped: %w", err)))
+		assert.False(t, test.check(errors.New("Received HTTP Status '404 Not Found'")))
+	}
+}
+
+func testCheckResponseDoEmptyNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/pipelines/p1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req, _ := client.NewRequest("GET", "admin/pipelines/p1", nil, apiV11)
+	_, err := client.Do(context.Background(), req, &Pipeline{}, responseTypeJSON)
+
+	apiErr, ok := err.(*APIError)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Empty(t, apiErr.Body)
+	assert.EqualError(t, err, "Received HTTP Status '404 Not Found'")
+}
+
+func testCheckResponseDoHTMLNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	html := "<html><body><h1>404 Not Found</h1></body></html>"
+	mux.HandleFunc("/api/admin/pipelines/p1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, html)
+	})
+
+	req, _ := client.NewRequest("GET", "admin/pipelines/p1", nil, apiV11)
+	resp, err := client.Do(context.Background(), req, &Pipeline{}, responseTypeJSON)
+
+	apiErr, ok := err.(*APIError)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, html, apiErr.Body)
+	assert.Equal(t, html, resp.Body)
+
+	b, _ := ioutil.ReadAll(resp.HTTP.Body)
+	assert.Equal(t, html, string(b))
+}
+
 func testCheckResponseValid(t *testing.T) {
 	err := CheckResponse(&APIResponse{
 		HTTP: &http.Response{
@@ -44,11 +44,15 @@ type Job struct {
 
 // codebeat:enable[TOO_MANY_IVARS]
 
-// Artifact describes the result of a job
+// Artifact describes the result of a job. Artifacts of type `build` and `test` are uploaded to the GoCD server from
+// the source path, while `external` artifacts are published to an artifact store by its plugin.
 type Artifact struct {
-	Type        string `json:"type"`
-	Source      string `json:"source"`
-	Destination string `json:"destination"`
+	Type          string                         `json:"type"`
+	Source        string                         `json:"source"`
+	Destination   string                         `json:"destination"`
+	ID            string                         `json:"artifact_id,omitempty"`
+	StoreID       string                         `json:"store_id,omitempty"`
+	Configuration []*PluginConfigurationProperty `json:"configuration,omitempty"`
 }
 
 // Tab description in a gocd job
@@ -0,0 +1,93 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// PackagesService exposes calls for interacting with packages, which are polled from a package repository and used as
+// `package` materials by pipelines.
+type PackagesService service
+
+// PackagesListResponse describes the structure of the API response when listing packages
+type PackagesListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		Packages []*Package `json:"packages"`
+	} `json:"_embedded,omitempty"`
+}
+
+// Package describes a package within a package repository
+type Package struct {
+	ID            string                         `json:"id"`
+	Name          string                         `json:"name"`
+	AutoUpdate    bool                           `json:"auto_update"`
+	PackageRepo   *PackageRepositoryReference    `json:"package_repo"`
+	Configuration []*PluginConfigurationProperty `json:"configuration,omitempty"`
+	Links         *HALLinks                      `json:"_links,omitempty"`
+	Version       string                         `json:"version,omitempty"`
+}
+
+// PackageRepositoryReference identifies the package repository a package belongs to
+type PackageRepositoryReference struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// List all packages
+func (pks *PackagesService) List(ctx context.Context) (packages []*Package, resp *APIResponse, err error) {
+	r := &PackagesListResponse{}
+	_, resp, err = pks.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/packages",
+		APIVersion:   apiV2,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.Packages, resp, err
+}
+
+// Get a package by id
+func (pks *PackagesService) Get(ctx context.Context, id string) (pkg *Package, resp *APIResponse, err error) {
+	pkg = &Package{}
+	_, resp, err = pks.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/packages/%s", id),
+		APIVersion:   apiV2,
+		ResponseBody: pkg,
+	})
+
+	return
+}
+
+// Create a package
+func (pks *PackagesService) Create(ctx context.Context, p *Package) (pkg *Package, resp *APIResponse, err error) {
+	pkg = &Package{}
+	_, resp, err = pks.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/packages",
+		APIVersion:   apiV2,
+		RequestBody:  p,
+		ResponseBody: pkg,
+	})
+
+	return
+}
+
+// Update a package. The version of the package must be set for the update to succeed.
+func (pks *PackagesService) Update(ctx context.Context, id string, p *Package) (pkg *Package, resp *APIResponse, err error) {
+	pkg = &Package{}
+	_, resp, err = pks.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/packages/%s", id),
+		APIVersion:   apiV2,
+		RequestBody:  p,
+		ResponseBody: pkg,
+	})
+
+	return
+}
+
+// Delete a package. Note: The package must not be used as a material by any pipeline.
+func (pks *PackagesService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return pks.client.deleteAction(ctx, fmt.Sprintf("admin/packages/%s", id), apiV2)
+}
@@ -0,0 +1,92 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// PackageRepositoriesService exposes calls for interacting with package repositories, which configure the package
+// material plugin used to poll a repository, eg a yum or debian repository.
+type PackageRepositoriesService service
+
+// PackageRepositoriesListResponse describes the structure of the API response when listing package repositories
+type PackageRepositoriesListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		PackageRepositories []*PackageRepository `json:"package_repositories"`
+	} `json:"_embedded,omitempty"`
+}
+
+// PackageRepository describes a package repository
+type PackageRepository struct {
+	ID             string                         `json:"repo_id"`
+	Name           string                         `json:"name"`
+	PluginMetadata *PluginMetadata                `json:"plugin_metadata"`
+	Configuration  []*PluginConfigurationProperty `json:"configuration,omitempty"`
+	Links          *HALLinks                      `json:"_links,omitempty"`
+	Version        string                         `json:"version,omitempty"`
+}
+
+// PluginMetadata identifies the plugin, and the version of the plugin, used by a package repository or SCM
+type PluginMetadata struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// List all package repositories
+func (prs *PackageRepositoriesService) List(ctx context.Context) (repos []*PackageRepository, resp *APIResponse, err error) {
+	r := &PackageRepositoriesListResponse{}
+	_, resp, err = prs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/repositories",
+		APIVersion:   apiV1,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.PackageRepositories, resp, err
+}
+
+// Get a package repository by id
+func (prs *PackageRepositoriesService) Get(ctx context.Context, id string) (repo *PackageRepository, resp *APIResponse, err error) {
+	repo = &PackageRepository{}
+	_, resp, err = prs.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/repositories/%s", id),
+		APIVersion:   apiV1,
+		ResponseBody: repo,
+	})
+
+	return
+}
+
+// Create a package repository
+func (prs *PackageRepositoriesService) Create(ctx context.Context, pr *PackageRepository) (repo *PackageRepository, resp *APIResponse, err error) {
+	repo = &PackageRepository{}
+	_, resp, err = prs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/repositories",
+		APIVersion:   apiV1,
+		RequestBody:  pr,
+		ResponseBody: repo,
+	})
+
+	return
+}
+
+// Update a package repository. The version of the repository must be set for the update to succeed.
+func (prs *PackageRepositoriesService) Update(ctx context.Context, id string, pr *PackageRepository) (repo *PackageRepository, resp *APIResponse, err error) {
+	repo = &PackageRepository{}
+	_, resp, err = prs.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/repositories/%s", id),
+		APIVersion:   apiV1,
+		RequestBody:  pr,
+		ResponseBody: repo,
+	})
+
+	return
+}
+
+// Delete a package repository. Note: The repository must not contain any packages.
+func (prs *PackageRepositoriesService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return prs.client.deleteAction(ctx, fmt.Sprintf("admin/repositories/%s", id), apiV1)
+}
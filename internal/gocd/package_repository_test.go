@@ -0,0 +1,139 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPackageRepositoriesService(t *testing.T) {
+	t.Run("List", testPackageRepositoriesServiceList)
+	t.Run("Get", testPackageRepositoriesServiceGet)
+	t.Run("Create", testPackageRepositoriesServiceCreate)
+	t.Run("Update", testPackageRepositoriesServiceUpdate)
+	t.Run("Delete", testPackageRepositoriesServiceDelete)
+}
+
+func testPackageRepositoriesServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/repositories", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/packagerepositories.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	repos, _, err := client.PackageRepositories.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, repos, 1)
+	testPackageRepository(t, repos[0])
+}
+
+func testPackageRepositoriesServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/repositories/dd8926c0-3b4a-4c9e-8012-957b179cec5b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/packagerepository.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	repo, _, err := client.PackageRepositories.Get(context.Background(), "dd8926c0-3b4a-4c9e-8012-957b179cec5b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", repo.Version)
+	testPackageRepository(t, repo)
+}
+
+func testPackageRepositoriesServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/repositories", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "repo_id": "dd8926c0-3b4a-4c9e-8012-957b179cec5b",
+  "name": "repository",
+  "plugin_metadata": {"id": "deb", "version": "1"},
+  "configuration": [
+    {"key": "REPO_URL", "value": "http://deb.example.com/debian"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/packagerepository.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	repo, _, err := client.PackageRepositories.Create(context.Background(), &PackageRepository{
+		ID:             "dd8926c0-3b4a-4c9e-8012-957b179cec5b",
+		Name:           "repository",
+		PluginMetadata: &PluginMetadata{ID: "deb", Version: "1"},
+		Configuration: []*PluginConfigurationProperty{
+			{Key: "REPO_URL", Value: "http://deb.example.com/debian"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testPackageRepository(t, repo)
+}
+
+func testPackageRepositoriesServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/repositories/dd8926c0-3b4a-4c9e-8012-957b179cec5b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/packagerepository.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	repo, _, err := client.PackageRepositories.Update(context.Background(), "dd8926c0-3b4a-4c9e-8012-957b179cec5b", &PackageRepository{
+		ID:             "dd8926c0-3b4a-4c9e-8012-957b179cec5b",
+		Name:           "repository",
+		PluginMetadata: &PluginMetadata{ID: "deb", Version: "1"},
+		Version:        "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", repo.Version)
+	testPackageRepository(t, repo)
+}
+
+func testPackageRepositoriesServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/repositories/dd8926c0-3b4a-4c9e-8012-957b179cec5b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The package repository 'dd8926c0-3b4a-4c9e-8012-957b179cec5b' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.PackageRepositories.Delete(context.Background(), "dd8926c0-3b4a-4c9e-8012-957b179cec5b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The package repository 'dd8926c0-3b4a-4c9e-8012-957b179cec5b' was deleted successfully.", message)
+}
+
+func testPackageRepository(t *testing.T, repo *PackageRepository) {
+	assert.Equal(t, "dd8926c0-3b4a-4c9e-8012-957b179cec5b", repo.ID)
+	assert.Equal(t, "repository", repo.Name)
+	assert.Equal(t, &PluginMetadata{ID: "deb", Version: "1"}, repo.PluginMetadata)
+	assert.Len(t, repo.Configuration, 1)
+	assert.Equal(t, "REPO_URL", repo.Configuration[0].Key)
+	assert.Equal(t, "http://deb.example.com/debian", repo.Configuration[0].Value)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/repositories/dd8926c0-3b4a-4c9e-8012-957b179cec5b", repo.Links.Get("Self").URL.String())
+}
@@ -0,0 +1,142 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPackagesService(t *testing.T) {
+	t.Run("List", testPackagesServiceList)
+	t.Run("Get", testPackagesServiceGet)
+	t.Run("Create", testPackagesServiceCreate)
+	t.Run("Update", testPackagesServiceUpdate)
+	t.Run("Delete", testPackagesServiceDelete)
+}
+
+func testPackagesServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/packages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/packages.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	pkgs, _, err := client.Packages.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, pkgs, 1)
+	testPackage(t, pkgs[0])
+}
+
+func testPackagesServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/packages/6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/package.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	pkg, _, err := client.Packages.Get(context.Background(), "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", pkg.Version)
+	testPackage(t, pkg)
+}
+
+func testPackagesServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/packages", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b",
+  "name": "package",
+  "auto_update": true,
+  "package_repo": {"id": "dd8926c0-3b4a-4c9e-8012-957b179cec5b"},
+  "configuration": [
+    {"key": "PACKAGE_NAME", "value": "gocd-agent"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/package.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	pkg, _, err := client.Packages.Create(context.Background(), &Package{
+		ID:          "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b",
+		Name:        "package",
+		AutoUpdate:  true,
+		PackageRepo: &PackageRepositoryReference{ID: "dd8926c0-3b4a-4c9e-8012-957b179cec5b"},
+		Configuration: []*PluginConfigurationProperty{
+			{Key: "PACKAGE_NAME", Value: "gocd-agent"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testPackage(t, pkg)
+}
+
+func testPackagesServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/packages/6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/package.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	pkg, _, err := client.Packages.Update(context.Background(), "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", &Package{
+		ID:          "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b",
+		Name:        "package",
+		PackageRepo: &PackageRepositoryReference{ID: "dd8926c0-3b4a-4c9e-8012-957b179cec5b"},
+		Version:     "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", pkg.Version)
+	testPackage(t, pkg)
+}
+
+func testPackagesServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/packages/6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The package '6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.Packages.Delete(context.Background(), "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The package '6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b' was deleted successfully.", message)
+}
+
+func testPackage(t *testing.T, pkg *Package) {
+	assert.Equal(t, "6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", pkg.ID)
+	assert.Equal(t, "package", pkg.Name)
+	assert.True(t, pkg.AutoUpdate)
+	assert.Equal(t, &PackageRepositoryReference{ID: "dd8926c0-3b4a-4c9e-8012-957b179cec5b", Name: "repository"}, pkg.PackageRepo)
+	assert.Len(t, pkg.Configuration, 2)
+	assert.Equal(t, "PACKAGE_NAME", pkg.Configuration[0].Key)
+	assert.Equal(t, "gocd-agent", pkg.Configuration[0].Value)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/packages/6bd1aa8f-2c8a-4a18-b4f4-75a2d2bfc14b", pkg.Links.Get("Self").URL.String())
+}
@@ -138,9 +138,16 @@ type Modification struct {
 
 // PipelineStatus describes whether a pipeline can be run or scheduled.
 type PipelineStatus struct {
-	Locked      bool `json:"locked"`
-	Paused      bool `json:"paused"`
-	Schedulable bool `json:"schedulable"`
+	Locked      bool   `json:"locked"`
+	Paused      bool   `json:"paused"`
+	PausedCause string `json:"pausedCause,omitempty"`
+	PausedBy    string `json:"pausedBy,omitempty"`
+	Schedulable bool   `json:"schedulable"`
+}
+
+// PauseRequestBody describes why a pipeline is being paused.
+type PauseRequestBody struct {
+	PauseCause string `json:"pause_cause"`
 }
 
 // ScheduleMaterial describes a material that must be used to trigger a new instance of the pipeline.
@@ -184,6 +191,16 @@ func (pgs *PipelinesService) Pause(ctx context.Context, name string) (bool, *API
 	})
 }
 
+// PauseWithCause stops a pipeline from handling new build events, recording why it was paused.
+func (pgs *PipelinesService) PauseWithCause(ctx context.Context, name string, cause string) (bool, *APIResponse, error) {
+	return pgs.pipelineAction(ctx, &pipelineActionRequest{
+		Action:   "pause",
+		Pipeline: name,
+		Body:     &PauseRequestBody{PauseCause: cause},
+		RawQuery: url.Values{"pauseCause": []string{cause}}.Encode(),
+	})
+}
+
 // Unpause allows a pipeline to handle new build events
 func (pgs *PipelinesService) Unpause(ctx context.Context, name string) (bool, *APIResponse, error) {
 	return pgs.pipelineAction(ctx, &pipelineActionRequest{
@@ -201,7 +218,7 @@ func (pgs *PipelinesService) ReleaseLock(ctx context.Context, name string) (bool
 
 	// if version is >= 18.2.0 use "unlock"
 	releaseLockBeforeVersion, _ := version.NewVersion("18.2.0")
-	v, _, err := pgs.client.ServerVersion.Get(context.Background())
+	v, _, err := pgs.client.ServerVersion.Get(ctx)
 	if err != nil {
 		return false, nil, err
 	}
@@ -33,7 +33,7 @@ func testPipelineServiceUnPause(t *testing.T) {
 			Stages: stages,
 		}
 
-		pausePipeline, _, err := intClient.PipelineConfigs.Create(ctx, mockTestingGroup, mockPipeline)
+		pausePipeline, _, err := intClient.PipelineConfigs.Create(ctx, mockTestingGroup, mockPipeline, nil)
 		assert.NoError(t, err)
 		pausePipeline.Links = nil
 		pausePipeline.Version = ""
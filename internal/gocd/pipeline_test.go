@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,6 +67,55 @@ func TestPipelineServiceSchedule(t *testing.T) {
 
 }
 
+func TestPipelineServicePauseWithCause(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		versionFile string
+		path        string
+		body        string
+	}{
+		{
+			name:        "unversionned",
+			versionFile: "test/resources/version.0.json",
+			path:        "/api/pipelines/test-pipeline/pause?pauseCause=Waiting+for+upstream",
+			body:        "",
+		},
+		{
+			name:        "18.2.0",
+			versionFile: "test/resources/version.2.json",
+			path:        "/api/pipelines/test-pipeline/pause",
+			body:        "{\n  \"pause_cause\": \"Waiting for upstream\"\n}",
+		},
+	} {
+
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/api/version", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.Method, "GET", "Unexpected HTTP method")
+				j, _ := ioutil.ReadFile(tt.versionFile)
+				fmt.Fprint(w, string(j))
+			})
+
+			mux.HandleFunc("/api/pipelines/test-pipeline/pause", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.Method, "POST", "Unexpected HTTP method")
+				assert.Equal(t, tt.path, r.URL.RequestURI())
+				reqBody, _ := ioutil.ReadAll(r.Body)
+				assert.Equal(t, tt.body, strings.TrimSpace(string(reqBody)))
+				fmt.Fprint(w, string([]byte(`{"message" : "Pipeline 'test-pipeline' paused successfully."}`)))
+			})
+
+			result, _, err := client.Pipelines.PauseWithCause(context.Background(), "test-pipeline", "Waiting for upstream")
+			if err != nil {
+				t.Error(err)
+			}
+
+			assert.True(t, result)
+		})
+	}
+}
+
 func TestPipelineServiceScheduleWithBody(t *testing.T) {
 	setup()
 	defer teardown()
@@ -229,6 +279,8 @@ func testPipelineServiceGetStatus(t *testing.T) {
 	assert.NotNil(t, ps)
 	assert.False(t, ps.Locked)
 	assert.True(t, ps.Paused)
+	assert.Equal(t, "Waiting for upstream", ps.PausedCause)
+	assert.Equal(t, "admin", ps.PausedBy)
 	assert.False(t, ps.Schedulable)
 }
 
@@ -285,7 +337,7 @@ func testPipelineServiceCreateDelete(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	pr, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", &p)
+	pr, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", &p, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, pr)
 	assert.Equal(t, "testPipelineServiceCreateDelete", pr.Name)
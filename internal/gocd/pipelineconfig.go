@@ -14,6 +14,13 @@ type PipelineConfigRequest struct {
 	Pipeline *Pipeline `json:"pipeline"`
 }
 
+// PipelineCreateOptions describes how a pipeline is set up when it is created.
+type PipelineCreateOptions struct {
+	// Paused creates the pipeline paused, so it is not triggered by its materials straight away.
+	Paused     bool
+	PauseCause string
+}
+
 // Get a single Pipeline object in the GoCD API.
 func (pcs *PipelineConfigsService) Get(ctx context.Context, name string) (p *Pipeline, resp *APIResponse, err error) {
 
@@ -53,8 +60,8 @@ func (pcs *PipelineConfigsService) Update(ctx context.Context, name string, p *P
 	return
 }
 
-// Create a pipeline configuration
-func (pcs *PipelineConfigsService) Create(ctx context.Context, group string, p *Pipeline) (pr *Pipeline, resp *APIResponse, err error) {
+// Create a pipeline configuration. The options may be nil.
+func (pcs *PipelineConfigsService) Create(ctx context.Context, group string, p *Pipeline, opts *PipelineCreateOptions) (pr *Pipeline, resp *APIResponse, err error) {
 
 	apiVersion, err := pcs.client.getAPIVersion(ctx, "admin/pipelines/:pipeline_name")
 	if err != nil {
@@ -62,7 +69,7 @@ func (pcs *PipelineConfigsService) Create(ctx context.Context, group string, p *
 	}
 
 	pr = &Pipeline{}
-	_, resp, err = pcs.client.postAction(ctx, &APIClientRequest{
+	request := &APIClientRequest{
 		Path:       "admin/pipelines",
 		APIVersion: apiVersion,
 		RequestBody: &PipelineConfigRequest{
@@ -70,7 +77,16 @@ func (pcs *PipelineConfigsService) Create(ctx context.Context, group string, p *
 			Pipeline: p,
 		},
 		ResponseBody: pr,
-	})
+	}
+
+	if opts != nil && opts.Paused {
+		request.Headers = map[string]string{"X-pause-pipeline": "true"}
+		if opts.PauseCause != "" {
+			request.Headers["X-pause-cause"] = opts.PauseCause
+		}
+	}
+
+	_, resp, err = pcs.client.postAction(ctx, request)
 
 	pr.Group = group
 
@@ -2,8 +2,11 @@ package gocd
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
 	"regexp"
 	"testing"
 )
@@ -28,7 +31,7 @@ func TestPipelineConfig(t *testing.T) {
 		Stages: buildUpstreamPipelineStages(),
 	}
 
-	_, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", upstream)
+	_, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", upstream, nil)
 	assert.NoError(t, err)
 
 	input := &Pipeline{
@@ -51,7 +54,7 @@ func TestPipelineConfig(t *testing.T) {
 		Stages: buildMockPipelineStagesWithFetch(),
 	}
 
-	p, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", input)
+	p, _, err := intClient.PipelineConfigs.Create(ctx, "test-group", input, nil)
 	assert.NoError(t, err)
 
 	// Make sure version-specific defaults are properly set
@@ -281,3 +284,49 @@ func buildMockPipelineStagesWithFetch() []*Stage {
 		EnvironmentVariables: make([]*EnvironmentVariable, 0),
 	}}
 }
+
+func TestPipelineConfigCreateOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		opts       *PipelineCreateOptions
+		pause      string
+		pauseCause string
+	}{
+		{
+			name: "NoOptions",
+		},
+		{
+			name:  "Paused",
+			opts:  &PipelineCreateOptions{Paused: true},
+			pause: "true",
+		},
+		{
+			name:       "PausedWithCause",
+			opts:       &PipelineCreateOptions{Paused: true, PauseCause: "Waiting for upstream"},
+			pause:      "true",
+			pauseCause: "Waiting for upstream",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/api/version", func(w http.ResponseWriter, r *http.Request) {
+				j, _ := ioutil.ReadFile("test/resources/version.2.json")
+				fmt.Fprint(w, string(j))
+			})
+
+			mux.HandleFunc("/api/admin/pipelines", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+				assert.Equal(t, tt.pause, r.Header.Get("X-pause-pipeline"))
+				assert.Equal(t, tt.pauseCause, r.Header.Get("X-pause-cause"))
+				fmt.Fprint(w, `{"name": "test-pipeline"}`)
+			})
+
+			p, _, err := client.PipelineConfigs.Create(context.Background(), "test-group", &Pipeline{Name: "test-pipeline"}, tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, "test-pipeline", p.Name)
+			assert.Equal(t, "test-group", p.Group)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package gocd
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 // PipelineGroupsService describes the HAL _link resource for the api response object for a pipeline group response.
 type PipelineGroupsService service
@@ -10,8 +13,25 @@ type PipelineGroups []*PipelineGroup
 
 // PipelineGroup describes a pipeline group API response.
 type PipelineGroup struct {
-	Name      string      `json:"name"`
-	Pipelines []*Pipeline `json:"pipelines"`
+	Links         *HALLinks                   `json:"_links,omitempty"`
+	Name          string                      `json:"name"`
+	Authorization *PipelineGroupAuthorization `json:"authorization,omitempty"`
+	Pipelines     []*Pipeline                 `json:"pipelines,omitempty"`
+	Version       string                      `json:"version,omitempty"`
+}
+
+// PipelineGroupAuthorization describes which users and roles may view, operate, or administer the pipelines in a
+// pipeline group.
+type PipelineGroupAuthorization struct {
+	View    *PipelineGroupAuthorizationMembers `json:"view,omitempty"`
+	Operate *PipelineGroupAuthorizationMembers `json:"operate,omitempty"`
+	Admins  *PipelineGroupAuthorizationMembers `json:"admins,omitempty"`
+}
+
+// PipelineGroupAuthorizationMembers describes the users and roles granted a permission on a pipeline group.
+type PipelineGroupAuthorizationMembers struct {
+	Users []string `json:"users"`
+	Roles []string `json:"roles"`
 }
 
 // List Pipeline groups
@@ -37,3 +57,46 @@ func (pgs *PipelineGroupsService) List(ctx context.Context, name string) (*Pipel
 
 	return &filtered, resp, err
 }
+
+// Get a single pipeline group, including its authorization, from the pipeline group config API.
+func (pgs *PipelineGroupsService) Get(ctx context.Context, name string) (pg *PipelineGroup, resp *APIResponse, err error) {
+	pg = &PipelineGroup{}
+	_, resp, err = pgs.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/pipeline_groups/%s", name),
+		APIVersion:   apiV1,
+		ResponseBody: pg,
+	})
+
+	return
+}
+
+// Create a pipeline group
+func (pgs *PipelineGroupsService) Create(ctx context.Context, group *PipelineGroup) (pg *PipelineGroup, resp *APIResponse, err error) {
+	pg = &PipelineGroup{}
+	_, resp, err = pgs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/pipeline_groups",
+		APIVersion:   apiV1,
+		RequestBody:  group,
+		ResponseBody: pg,
+	})
+
+	return
+}
+
+// Update the authorization of a pipeline group. The version of the group must be set for the update to succeed.
+func (pgs *PipelineGroupsService) Update(ctx context.Context, name string, group *PipelineGroup) (pg *PipelineGroup, resp *APIResponse, err error) {
+	pg = &PipelineGroup{}
+	_, resp, err = pgs.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/pipeline_groups/%s", name),
+		APIVersion:   apiV1,
+		RequestBody:  group,
+		ResponseBody: pg,
+	})
+
+	return
+}
+
+// Delete a pipeline group. Note: The pipeline group must not contain any pipelines to be deleted.
+func (pgs *PipelineGroupsService) Delete(ctx context.Context, name string) (string, *APIResponse, error) {
+	return pgs.client.deleteAction(ctx, fmt.Sprintf("admin/pipeline_groups/%s", name), apiV1)
+}
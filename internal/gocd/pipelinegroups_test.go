@@ -12,6 +12,109 @@ import (
 func TestPipelineGroupsService(t *testing.T) {
 	t.Run("List", testPipelineGroupsServiceList)
 	t.Run("Filter", testPipelineGroupsServiceFilter)
+	t.Run("Get", testPipelineGroupsServiceGet)
+	t.Run("Create", testPipelineGroupsServiceCreate)
+	t.Run("Update", testPipelineGroupsServiceUpdate)
+	t.Run("Delete", testPipelineGroupsServiceDelete)
+}
+
+func testPipelineGroupsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/pipeline_groups/first", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/pipelinegroup.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	pg, _, err := client.PipelineGroups.Get(context.Background(), "first")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", pg.Version)
+	testPipelineGroup(t, pg)
+}
+
+func testPipelineGroupsServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/pipeline_groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "name": "first",
+  "authorization": {
+    "view": {"users": ["alice"], "roles": []},
+    "admins": {"users": [], "roles": ["admins"]}
+  }
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/pipelinegroup.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	pg, _, err := client.PipelineGroups.Create(context.Background(), &PipelineGroup{
+		Name: "first",
+		Authorization: &PipelineGroupAuthorization{
+			View:   &PipelineGroupAuthorizationMembers{Users: []string{"alice"}, Roles: []string{}},
+			Admins: &PipelineGroupAuthorizationMembers{Users: []string{}, Roles: []string{"admins"}},
+		},
+	})
+
+	assert.Nil(t, err)
+	testPipelineGroup(t, pg)
+}
+
+func testPipelineGroupsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/pipeline_groups/first", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/pipelinegroup.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	pg, _, err := client.PipelineGroups.Update(context.Background(), "first", &PipelineGroup{
+		Name:    "first",
+		Version: "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", pg.Version)
+	testPipelineGroup(t, pg)
+}
+
+func testPipelineGroupsServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/pipeline_groups/first", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The pipeline group 'first' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.PipelineGroups.Delete(context.Background(), "first")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The pipeline group 'first' was deleted successfully.", message)
+}
+
+func testPipelineGroup(t *testing.T, pg *PipelineGroup) {
+	assert.Equal(t, "first", pg.Name)
+	assert.Equal(t, []string{"alice"}, pg.Authorization.View.Users)
+	assert.Equal(t, []string{"operators"}, pg.Authorization.Operate.Roles)
+	assert.Equal(t, []string{"admins"}, pg.Authorization.Admins.Roles)
+	assert.Len(t, pg.Pipelines, 1)
+	assert.Equal(t, "up42", pg.Pipelines[0].Name)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/pipeline_groups/first", pg.Links.Get("Self").URL.String())
 }
 
 func testPipelineGroupsServiceFilter(t *testing.T) {
@@ -96,17 +96,19 @@ type PluginExtensionInfo struct {
 // PluginExtension describes the different extensions available for a plugin. It is used for the plugin API v4 (GoCD >= 18.3.0).
 // codebeat:disable[TOO_MANY_IVARS]
 type PluginExtension struct {
-	Type               string                `json:"type,omitempty"`
-	PluginSettings     ExtensionSettings     `json:"plugin_settings,omitempty"`
-	ProfileSettings    ExtensionSettings     `json:"profile_settings,omitempty"`
-	Capabilities       ExtensionCapabilities `json:"capabilities,omitempty"`
-	AuthConfigSettings ExtensionSettings     `json:"auth_config_settings,omitempty"`
-	RoleSettings       ExtensionSettings     `json:"role_settings,omitempty"`
-	DisplayName        string                `json:"display_name,omitempty"`
-	ScmSettings        ExtensionSettings     `json:"scm_settings,omitempty"`
-	TaskSettings       ExtensionSettings     `json:"task_settings,omitempty"`
-	PackageSettings    ExtensionSettings     `json:"package_settings,omitempty"`
-	RepositorySettings ExtensionSettings     `json:"repository_settings,omitempty"`
+	Type                        string                `json:"type,omitempty"`
+	PluginSettings              ExtensionSettings     `json:"plugin_settings,omitempty"`
+	ProfileSettings             ExtensionSettings     `json:"profile_settings,omitempty"`
+	ElasticAgentProfileSettings ExtensionSettings     `json:"elastic_agent_profile_settings,omitempty"` // ElasticAgentProfileSettings replaced ProfileSettings in the plugin API v5 (GoCD >= 19.3.0).
+	ClusterProfileSettings      ExtensionSettings     `json:"cluster_profile_settings,omitempty"`       // ClusterProfileSettings is available since the plugin API v5 (GoCD >= 19.3.0).
+	Capabilities                ExtensionCapabilities `json:"capabilities,omitempty"`
+	AuthConfigSettings          ExtensionSettings     `json:"auth_config_settings,omitempty"`
+	RoleSettings                ExtensionSettings     `json:"role_settings,omitempty"`
+	DisplayName                 string                `json:"display_name,omitempty"`
+	ScmSettings                 ExtensionSettings     `json:"scm_settings,omitempty"`
+	TaskSettings                ExtensionSettings     `json:"task_settings,omitempty"`
+	PackageSettings             ExtensionSettings     `json:"package_settings,omitempty"`
+	RepositorySettings          ExtensionSettings     `json:"repository_settings,omitempty"`
 }
 
 // codebeat:enable[TOO_MANY_IVARS]
@@ -146,6 +148,14 @@ type PluginConfigurationMetadata struct {
 	DisplayName    string `json:"display_name,omitempty"`
 }
 
+// PluginConfigurationProperty describes a key/value pair configuring a plugin backed object, such as a profile. Values
+// for secure keys are returned by the GoCD API as an encrypted value only.
+type PluginConfigurationProperty struct {
+	Key            string `json:"key"`
+	Value          string `json:"value,omitempty"`
+	EncryptedValue string `json:"encrypted_value,omitempty"`
+}
+
 // PluginView describes any view attached to a plugin.
 type PluginView struct {
 	Template string `json:"template"`
@@ -0,0 +1,56 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// PluginSettingsService exposes calls for interacting with the settings of a plugin, which configure the plugin as a
+// whole rather than a single profile or material.
+type PluginSettingsService service
+
+// PluginSettings describes the settings of a plugin
+type PluginSettings struct {
+	PluginID      string                         `json:"plugin_id"`
+	Configuration []*PluginConfigurationProperty `json:"configuration"`
+	Links         *HALLinks                      `json:"_links,omitempty"`
+	Version       string                         `json:"version,omitempty"`
+}
+
+// Get the settings of a plugin by plugin id
+func (pss *PluginSettingsService) Get(ctx context.Context, pluginID string) (settings *PluginSettings, resp *APIResponse, err error) {
+	settings = &PluginSettings{}
+	_, resp, err = pss.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/plugin_settings/%s", pluginID),
+		APIVersion:   apiV1,
+		ResponseBody: settings,
+	})
+
+	return
+}
+
+// Create the settings of a plugin. The settings of a plugin can only be created once.
+func (pss *PluginSettingsService) Create(ctx context.Context, ps *PluginSettings) (settings *PluginSettings, resp *APIResponse, err error) {
+	settings = &PluginSettings{}
+	_, resp, err = pss.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/plugin_settings",
+		APIVersion:   apiV1,
+		RequestBody:  ps,
+		ResponseBody: settings,
+	})
+
+	return
+}
+
+// Update the settings of a plugin. The version of the settings must be set for the update to succeed.
+func (pss *PluginSettingsService) Update(ctx context.Context, ps *PluginSettings) (settings *PluginSettings, resp *APIResponse, err error) {
+	settings = &PluginSettings{}
+	_, resp, err = pss.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/plugin_settings/%s", ps.PluginID),
+		APIVersion:   apiV1,
+		RequestBody:  ps,
+		ResponseBody: settings,
+	})
+
+	return
+}
@@ -0,0 +1,96 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPluginSettingsService(t *testing.T) {
+	t.Run("Get", testPluginSettingsServiceGet)
+	t.Run("Create", testPluginSettingsServiceCreate)
+	t.Run("Update", testPluginSettingsServiceUpdate)
+}
+
+func testPluginSettingsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/plugin_settings/github.oauth.login", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/pluginsettings.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	settings, _, err := client.PluginSettings.Get(context.Background(), "github.oauth.login")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", settings.Version)
+	testPluginSettings(t, settings)
+}
+
+func testPluginSettingsServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/plugin_settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "plugin_id": "github.oauth.login",
+  "configuration": [
+    {"key": "server_base_url", "value": "https://ci.example.com/go"},
+    {"key": "consumer_secret", "encrypted_value": "AES:lzcCuNSe4vUx+CsWgN11Uw==:Ebq9tuxmWJgv2p3UEaXsYg=="}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/pluginsettings.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	settings, _, err := client.PluginSettings.Create(context.Background(), &PluginSettings{
+		PluginID: "github.oauth.login",
+		Configuration: []*PluginConfigurationProperty{
+			{Key: "server_base_url", Value: "https://ci.example.com/go"},
+			{Key: "consumer_secret", EncryptedValue: "AES:lzcCuNSe4vUx+CsWgN11Uw==:Ebq9tuxmWJgv2p3UEaXsYg=="},
+		},
+	})
+
+	assert.Nil(t, err)
+	testPluginSettings(t, settings)
+}
+
+func testPluginSettingsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/plugin_settings/github.oauth.login", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/pluginsettings.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	settings, _, err := client.PluginSettings.Update(context.Background(), &PluginSettings{
+		PluginID: "github.oauth.login",
+		Version:  "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", settings.Version)
+	testPluginSettings(t, settings)
+}
+
+func testPluginSettings(t *testing.T, settings *PluginSettings) {
+	assert.Equal(t, "github.oauth.login", settings.PluginID)
+	assert.Len(t, settings.Configuration, 2)
+	assert.Equal(t, "https://ci.example.com/go", settings.Configuration[0].Value)
+	assert.Equal(t, "consumer_secret", settings.Configuration[1].Key)
+	assert.Equal(t, "AES:lzcCuNSe4vUx+CsWgN11Uw==:Ebq9tuxmWJgv2p3UEaXsYg==", settings.Configuration[1].EncryptedValue)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/plugin_settings/github.oauth.login", settings.Links.Get("Self").URL.String())
+}
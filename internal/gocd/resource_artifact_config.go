@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this artifact config
+func (ac *ArtifactConfig) SetVersion(version string) {
+	ac.Version = version
+}
+
+// GetVersion retrieves a version string for this artifact config
+func (ac *ArtifactConfig) GetVersion() (version string) {
+	return ac.Version
+}
+
+// RemoveLinks from the artifact config object for json marshalling.
+func (ac *ArtifactConfig) RemoveLinks() {
+	ac.Links = nil
+}
+
+// GetLinks from artifact config
+func (ac *ArtifactConfig) GetLinks() *HALLinks {
+	return ac.Links
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this artifact store
+func (st *ArtifactStore) SetVersion(version string) {
+	st.Version = version
+}
+
+// GetVersion retrieves a version string for this artifact store
+func (st *ArtifactStore) GetVersion() (version string) {
+	return st.Version
+}
+
+// RemoveLinks from the artifact store object for json marshalling.
+func (st *ArtifactStore) RemoveLinks() {
+	st.Links = nil
+}
+
+// GetLinks from artifact store
+func (st *ArtifactStore) GetLinks() *HALLinks {
+	return st.Links
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this cluster profile
+func (cp *ClusterProfile) SetVersion(version string) {
+	cp.Version = version
+}
+
+// GetVersion retrieves a version string for this cluster profile
+func (cp *ClusterProfile) GetVersion() (version string) {
+	return cp.Version
+}
+
+// RemoveLinks from the cluster profile object for json marshalling.
+func (cp *ClusterProfile) RemoveLinks() {
+	cp.Links = nil
+}
+
+// GetLinks from cluster profile
+func (cp *ClusterProfile) GetLinks() *HALLinks {
+	return cp.Links
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this elastic agent profile
+func (ep *ElasticProfile) SetVersion(version string) {
+	ep.Version = version
+}
+
+// GetVersion retrieves a version string for this elastic agent profile
+func (ep *ElasticProfile) GetVersion() (version string) {
+	return ep.Version
+}
+
+// RemoveLinks from the elastic agent profile object for json marshalling.
+func (ep *ElasticProfile) RemoveLinks() {
+	ep.Links = nil
+}
+
+// GetLinks from elastic agent profile
+func (ep *ElasticProfile) GetLinks() *HALLinks {
+	return ep.Links
+}
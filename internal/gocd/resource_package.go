@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this package
+func (p *Package) SetVersion(version string) {
+	p.Version = version
+}
+
+// GetVersion retrieves a version string for this package
+func (p *Package) GetVersion() (version string) {
+	return p.Version
+}
+
+// RemoveLinks from the package object for json marshalling.
+func (p *Package) RemoveLinks() {
+	p.Links = nil
+}
+
+// GetLinks from package
+func (p *Package) GetLinks() *HALLinks {
+	return p.Links
+}
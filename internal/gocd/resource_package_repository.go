@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this package repository
+func (pr *PackageRepository) SetVersion(version string) {
+	pr.Version = version
+}
+
+// GetVersion retrieves a version string for this package repository
+func (pr *PackageRepository) GetVersion() (version string) {
+	return pr.Version
+}
+
+// RemoveLinks from the package repository object for json marshalling.
+func (pr *PackageRepository) RemoveLinks() {
+	pr.Links = nil
+}
+
+// GetLinks from package repository
+func (pr *PackageRepository) GetLinks() *HALLinks {
+	return pr.Links
+}
@@ -16,7 +16,14 @@ func (mapp MaterialAttributesPlugin) equal(mapp2i MaterialAttribute) (bool, erro
 
 // GenerateGeneric form (map[string]interface) of the material filter
 func (mapp MaterialAttributesPlugin) GenerateGeneric() (ma map[string]interface{}) {
-	ma = make(map[string]interface{})
+	ma = map[string]interface{}{
+		"ref":           mapp.Ref,
+		"destination":   mapp.Destination,
+		"invert_filter": mapp.InvertFilter,
+	}
+	if f := mapp.Filter.GenerateGeneric(); f != nil {
+		ma["filter"] = f
+	}
 	return
 }
 
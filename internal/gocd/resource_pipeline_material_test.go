@@ -95,6 +95,32 @@ func testMaterialAttributeGeneric(t *testing.T) {
 				"invert_filter": true,
 			},
 		},
+		{
+			a: MaterialAttributesPackage{
+				Ref: "mock-ref",
+			},
+			m: map[string]interface{}{
+				"ref": "mock-ref",
+			},
+		},
+		{
+			a: MaterialAttributesPlugin{
+				Ref:         "mock-ref",
+				Destination: "mock-destination",
+				Filter: &MaterialFilter{
+					Ignore: []string{"mock-ignore"},
+				},
+				InvertFilter: true,
+			},
+			m: map[string]interface{}{
+				"ref":         "mock-ref",
+				"destination": "mock-destination",
+				"filter": map[string]interface{}{
+					"ignore": []interface{}{"mock-ignore"},
+				},
+				"invert_filter": true,
+			},
+		},
 	} {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			assert.Equal(t, test.m, test.a.GenerateGeneric())
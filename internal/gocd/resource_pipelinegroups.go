@@ -16,3 +16,26 @@ func (pg *PipelineGroups) GetGroupByPipelineName(pipelineName string) *PipelineG
 func (pg *PipelineGroups) GetGroupByPipeline(pipeline *Pipeline) *PipelineGroup {
 	return pg.GetGroupByPipelineName(pipeline.Name)
 }
+
+// SetVersion sets a version string for this pipeline group
+func (pg *PipelineGroup) SetVersion(version string) {
+	pg.Version = version
+}
+
+// GetVersion retrieves a version string for this pipeline group
+func (pg *PipelineGroup) GetVersion() (version string) {
+	return pg.Version
+}
+
+// RemoveLinks from the pipeline group object for json marshalling.
+func (pg *PipelineGroup) RemoveLinks() {
+	pg.Links = nil
+	for _, p := range pg.Pipelines {
+		p.RemoveLinks()
+	}
+}
+
+// GetLinks from pipeline group
+func (pg *PipelineGroup) GetLinks() *HALLinks {
+	return pg.Links
+}
@@ -0,0 +1,63 @@
+package gocd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GetExtension returns the extension of the given type implemented by this plugin, or nil if the plugin does not
+// implement it.
+func (p *Plugin) GetExtension(extensionType string) *PluginExtension {
+	for _, extension := range p.Extensions {
+		if extension.Type == extensionType {
+			return extension
+		}
+	}
+	return nil
+}
+
+// GetPluginSettings returns the plugin settings advertised by the first extension of this plugin which has any, or nil
+// if the plugin cannot be configured through plugin settings.
+func (p *Plugin) GetPluginSettings() *ExtensionSettings {
+	for _, extension := range p.Extensions {
+		if len(extension.PluginSettings.Configurations) > 0 {
+			return &extension.PluginSettings
+		}
+	}
+	return nil
+}
+
+// ValidateProperties checks that every property is a configuration key advertised by the plugin, and that every
+// required configuration key has been provided.
+func (es ExtensionSettings) ValidateProperties(properties []*PluginConfigurationProperty) error {
+	provided := map[string]bool{}
+	problems := []string{}
+
+	for _, property := range properties {
+		provided[property.Key] = true
+		if es.GetConfiguration(property.Key) == nil {
+			problems = append(problems, fmt.Sprintf("unknown key '%s'", property.Key))
+		}
+	}
+
+	for _, configuration := range es.Configurations {
+		if configuration.Metadata.Required && !provided[configuration.Key] {
+			problems = append(problems, fmt.Sprintf("missing required key '%s'", configuration.Key))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid plugin configuration: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// GetConfiguration returns the configuration advertised for a key, or nil if the key is not known to the plugin.
+func (es ExtensionSettings) GetConfiguration(key string) *PluginConfiguration {
+	for _, configuration := range es.Configurations {
+		if configuration.Key == key {
+			return configuration
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for these plugin settings
+func (ps *PluginSettings) SetVersion(version string) {
+	ps.Version = version
+}
+
+// GetVersion retrieves a version string for these plugin settings
+func (ps *PluginSettings) GetVersion() (version string) {
+	return ps.Version
+}
+
+// RemoveLinks from the plugin settings object for json marshalling.
+func (ps *PluginSettings) RemoveLinks() {
+	ps.Links = nil
+}
+
+// GetLinks from plugin settings
+func (ps *PluginSettings) GetLinks() *HALLinks {
+	return ps.Links
+}
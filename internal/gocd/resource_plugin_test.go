@@ -0,0 +1,61 @@
+package gocd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResourcePlugin(t *testing.T) {
+	t.Run("GetExtension", testResourcePluginGetExtension)
+	t.Run("GetPluginSettings", testResourcePluginGetPluginSettings)
+	t.Run("ValidateProperties", testResourcePluginValidateProperties)
+}
+
+func testResourcePluginGetExtension(t *testing.T) {
+	p := &Plugin{
+		Extensions: []*PluginExtension{
+			{Type: "elastic-agent"},
+			{Type: "authorization"},
+		},
+	}
+
+	assert.Equal(t, "authorization", p.GetExtension("authorization").Type)
+	assert.Nil(t, p.GetExtension("scm"))
+}
+
+func testResourcePluginGetPluginSettings(t *testing.T) {
+	p := &Plugin{
+		Extensions: []*PluginExtension{
+			{Type: "elastic-agent"},
+			{
+				Type: "notification",
+				PluginSettings: ExtensionSettings{
+					Configurations: []*PluginConfiguration{{Key: "WebhookUrl"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "WebhookUrl", p.GetPluginSettings().Configurations[0].Key)
+	assert.Nil(t, (&Plugin{Extensions: []*PluginExtension{{Type: "scm"}}}).GetPluginSettings())
+}
+
+func testResourcePluginValidateProperties(t *testing.T) {
+	settings := ExtensionSettings{
+		Configurations: []*PluginConfiguration{
+			{Key: "Image", Metadata: PluginConfigurationMetadata{Required: true}},
+			{Key: "Environment", Metadata: PluginConfigurationMetadata{Required: false}},
+			{Key: "Token", Metadata: PluginConfigurationMetadata{Required: true, Secure: true}},
+		},
+	}
+
+	assert.NoError(t, settings.ValidateProperties([]*PluginConfigurationProperty{
+		{Key: "Image", Value: "alpine:latest"},
+		{Key: "Token", EncryptedValue: "AES:mock"},
+	}))
+
+	assert.EqualError(t, settings.ValidateProperties([]*PluginConfigurationProperty{
+		{Key: "Image", Value: "alpine:latest"},
+		{Key: "Memory", Value: "1G"},
+	}), "invalid plugin configuration: unknown key 'Memory', missing required key 'Token'")
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this pluggable SCM
+func (s *SCM) SetVersion(version string) {
+	s.Version = version
+}
+
+// GetVersion retrieves a version string for this pluggable SCM
+func (s *SCM) GetVersion() (version string) {
+	return s.Version
+}
+
+// RemoveLinks from the pluggable SCM object for json marshalling.
+func (s *SCM) RemoveLinks() {
+	s.Links = nil
+}
+
+// GetLinks from pluggable SCM
+func (s *SCM) GetLinks() *HALLinks {
+	return s.Links
+}
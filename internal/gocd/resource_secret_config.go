@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this secret configuration
+func (sc *SecretConfig) SetVersion(version string) {
+	sc.Version = version
+}
+
+// GetVersion retrieves a version string for this secret configuration
+func (sc *SecretConfig) GetVersion() (version string) {
+	return sc.Version
+}
+
+// RemoveLinks from the secret configuration object for json marshalling.
+func (sc *SecretConfig) RemoveLinks() {
+	sc.Links = nil
+}
+
+// GetLinks from secret configuration
+func (sc *SecretConfig) GetLinks() *HALLinks {
+	return sc.Links
+}
@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for this authorization configuration
+func (ac *AuthConfig) SetVersion(version string) {
+	ac.Version = version
+}
+
+// GetVersion retrieves a version string for this authorization configuration
+func (ac *AuthConfig) GetVersion() (version string) {
+	return ac.Version
+}
+
+// RemoveLinks from the authorization configuration object for json marshalling.
+func (ac *AuthConfig) RemoveLinks() {
+	ac.Links = nil
+}
+
+// GetLinks from authorization configuration
+func (ac *AuthConfig) GetLinks() *HALLinks {
+	return ac.Links
+}
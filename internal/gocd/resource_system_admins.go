@@ -0,0 +1,21 @@
+package gocd
+
+// SetVersion sets a version string for these system admins
+func (sa *SystemAdmins) SetVersion(version string) {
+	sa.Version = version
+}
+
+// GetVersion retrieves a version string for these system admins
+func (sa *SystemAdmins) GetVersion() (version string) {
+	return sa.Version
+}
+
+// RemoveLinks from the system admins object for json marshalling.
+func (sa *SystemAdmins) RemoveLinks() {
+	sa.Links = nil
+}
+
+// GetLinks from system admins
+func (sa *SystemAdmins) GetLinks() *HALLinks {
+	return sa.Links
+}
@@ -18,8 +18,20 @@ func TestResource(t *testing.T) {
 func testResourceVersioned(t *testing.T) {
 	vers := map[string]Versioned{
 		"Environment":             &Environment{Version: "mock-version1"},
+		"ElasticProfile":          &ElasticProfile{Version: "mock-version1"},
+		"ClusterProfile":          &ClusterProfile{Version: "mock-version1"},
+		"AuthConfig":              &AuthConfig{Version: "mock-version1"},
+		"SecretConfig":            &SecretConfig{Version: "mock-version1"},
+		"ArtifactStore":           &ArtifactStore{Version: "mock-version1"},
+		"PackageRepository":       &PackageRepository{Version: "mock-version1"},
+		"Package":                 &Package{Version: "mock-version1"},
+		"SCM":                     &SCM{Version: "mock-version1"},
+		"PluginSettings":          &PluginSettings{Version: "mock-version1"},
+		"ArtifactConfig":          &ArtifactConfig{Version: "mock-version1"},
+		"SystemAdmins":            &SystemAdmins{Version: "mock-version1"},
 		"PipelineTemplate":        &PipelineTemplate{Version: "mock-version1"},
 		"PipelineConfigRequest":   &PipelineConfigRequest{Pipeline: &Pipeline{Version: "mock-version1"}},
+		"PipelineGroup":           &PipelineGroup{Version: "mock-version1"},
 		"PipelineTemplateRequest": &PipelineTemplateRequest{Version: "mock-version1"},
 		"Role":                    &Role{Version: "mock-version1"},
 	}
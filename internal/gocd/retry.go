@@ -0,0 +1,124 @@
+package gocd
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default waits of the BackoffRetryPolicy
+const (
+	DefaultRetryMinWait = 1 * time.Second
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy decides whether a failed request is sent again, and how long to wait before doing so.
+type RetryPolicy interface {
+	// Retry is called after the given attempt of req failed, starting at 1. resp is nil if no response was received.
+	Retry(attempt int, req *http.Request, resp *http.Response, err error) (wait time.Duration, retry bool)
+}
+
+// BackoffRetryPolicy retries transient failures with an exponential backoff and jitter, or after the delay requested by
+// the server in a `Retry-After` header.
+//
+// Requests with an idempotent method are retried when they could not be sent, and when GoCD reports a conflicting
+// config save (409, 412), too many requests (429) or is unavailable (502, 503, 504). A 412 in response to an `If-Match`
+// header is not retried, as the same version would be rejected again; see Client.UpdateWithConflictResolution. Other
+// requests, such as POST, are only retried when GoCD refused to handle them (429, 503), so that they are never applied
+// twice.
+type BackoffRetryPolicy struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+// Retry implements RetryPolicy.
+func (p *BackoffRetryPolicy) Retry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt > p.MaxRetries || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+
+	if !isRetryable(req, resp) {
+		return 0, false
+	}
+
+	maxWait := p.MaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > maxWait {
+				wait = maxWait
+			}
+			return wait, true
+		}
+	}
+
+	return p.backoff(attempt, maxWait), true
+}
+
+// backoff returns a random wait between half and all of the exponential delay of the attempt.
+func (p *BackoffRetryPolicy) backoff(attempt int, maxWait time.Duration) time.Duration {
+	wait := p.MinWait
+	if wait <= 0 {
+		wait = DefaultRetryMinWait
+	}
+	for i := 1; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+	if wait > maxWait {
+		wait = maxWait
+	}
+
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	idempotent := isIdempotent(req.Method)
+
+	if resp == nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusConflict, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	case http.StatusPreconditionFailed:
+		return idempotent && req.Header.Get("If-Match") == ""
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter reads a `Retry-After` header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
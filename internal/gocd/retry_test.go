@@ -0,0 +1,233 @@
+package gocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	t.Run("RetryUnavailable", testRetryUnavailable)
+	t.Run("RetryUnavailableHTML", testRetryUnavailableHTML)
+	t.Run("RetryResendsBody", testRetryResendsBody)
+	t.Run("RetryExhausted", testRetryExhausted)
+	t.Run("NoRetryPost", testNoRetryPost)
+	t.Run("NoRetryWithoutPolicy", testNoRetryWithoutPolicy)
+	t.Run("NoRetryHTMLNotFound", testNoRetryHTMLNotFound)
+	t.Run("Policy", testBackoffRetryPolicy)
+	t.Run("RetryAfter", testParseRetryAfter)
+}
+
+func newRetryClient(maxRetries int) *Client {
+	c := NewClient(&Configuration{
+		Server:     server.URL,
+		MaxRetries: maxRetries,
+	}, nil)
+	c.RetryPolicy.(*BackoffRetryPolicy).MinWait = time.Millisecond
+	return c
+}
+
+func testRetryUnavailable(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/unavailable", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"name":"ok"}`)
+	})
+
+	c := newRetryClient(3)
+	req, _ := c.NewRequest("GET", "retry/unavailable", nil, "")
+	v := map[string]string{}
+	_, err := c.Do(context.Background(), req, &v, responseTypeJSON)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, "ok", v["name"])
+}
+
+// testRetryUnavailableHTML retries a POST answered by the HTML page of a restarting server or proxy, after the delay
+// of its Retry-After header rather than the much longer backoff.
+func testRetryUnavailableHTML(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/unavailable-html", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Content-Type", "text/html")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "<html><body><h1>GoCD is starting up</h1></body></html>")
+			return
+		}
+		fmt.Fprint(w, `{"name":"ok"}`)
+	})
+
+	c := newRetryClient(3)
+	c.RetryPolicy.(*BackoffRetryPolicy).MinWait = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, _ := c.NewRequest("POST", "retry/unavailable-html", map[string]string{}, "")
+	v := map[string]string{}
+	_, err := c.Do(ctx, req, &v, responseTypeJSON)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, "ok", v["name"])
+}
+
+func testRetryResendsBody(t *testing.T) {
+	bodies := []string{}
+	mux.HandleFunc("/api/retry/body", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message":"Config has been modified"}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	c := newRetryClient(1)
+	req, _ := c.NewRequest("PUT", "retry/body", map[string]string{"name": "p1"}, "")
+	_, err := c.Do(context.Background(), req, &map[string]string{}, responseTypeJSON)
+
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Contains(t, bodies[1], `"name": "p1"`)
+}
+
+func testRetryExhausted(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/exhausted", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	c := newRetryClient(2)
+	req, _ := c.NewRequest("GET", "retry/exhausted", nil, "")
+	_, err := c.Do(context.Background(), req, &map[string]string{}, responseTypeJSON)
+
+	assert.Error(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func testNoRetryPost(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/post", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	c := newRetryClient(3)
+	req, _ := c.NewRequest("POST", "retry/post", map[string]string{}, "")
+	_, err := c.Do(context.Background(), req, &map[string]string{}, responseTypeJSON)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func testNoRetryWithoutPolicy(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/none", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("GET", "retry/none", nil, "")
+	_, err := client.Do(context.Background(), req, &map[string]string{}, responseTypeJSON)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func testNoRetryHTMLNotFound(t *testing.T) {
+	calls := 0
+	mux.HandleFunc("/api/retry/not-found-html", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, "<html><body><h1>404 Not Found</h1></body></html>")
+	})
+
+	c := newRetryClient(3)
+	req, _ := c.NewRequest("GET", "retry/not-found-html", nil, "")
+	_, err := c.Do(context.Background(), req, &map[string]string{}, responseTypeJSON)
+
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 1, calls)
+}
+
+func testBackoffRetryPolicy(t *testing.T) {
+	p := &BackoffRetryPolicy{MaxRetries: 5, MinWait: time.Second, MaxWait: 4 * time.Second}
+	get, _ := http.NewRequest("GET", "https://gocd.example.com/go/api/version", nil)
+	post, _ := http.NewRequest("POST", "https://gocd.example.com/go/api/admin/pipelines", nil)
+	put, _ := http.NewRequest("PUT", "https://gocd.example.com/go/api/admin/pipelines/p1", nil)
+	put.Header.Set("If-Match", `"abc"`)
+
+	for _, test := range []struct {
+		name    string
+		attempt int
+		req     *http.Request
+		resp    *http.Response
+		err     error
+		retry   bool
+		min     time.Duration
+		max     time.Duration
+	}{
+		{name: "first", attempt: 1, req: get, resp: &http.Response{StatusCode: 503}, retry: true, min: 500 * time.Millisecond, max: time.Second},
+		{name: "third", attempt: 3, req: get, resp: &http.Response{StatusCode: 503}, retry: true, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "capped", attempt: 5, req: get, resp: &http.Response{StatusCode: 503}, retry: true, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "exhausted", attempt: 6, req: get, resp: &http.Response{StatusCode: 503}},
+		{name: "network-get", attempt: 1, req: get, err: errors.New("connection refused"), retry: true, min: 500 * time.Millisecond, max: time.Second},
+		{name: "network-post", attempt: 1, req: post, err: errors.New("connection refused")},
+		{name: "conflict-post", attempt: 1, req: post, resp: &http.Response{StatusCode: 409}},
+		{name: "unavailable-post", attempt: 1, req: post, resp: &http.Response{StatusCode: 503}, retry: true, min: 500 * time.Millisecond, max: time.Second},
+		{name: "precondition-get", attempt: 1, req: get, resp: &http.Response{StatusCode: 412}, retry: true, min: 500 * time.Millisecond, max: time.Second},
+		{name: "precondition-if-match", attempt: 1, req: put, resp: &http.Response{StatusCode: 412}},
+		{name: "not-found", attempt: 1, req: get, resp: &http.Response{StatusCode: 404}},
+		{name: "cancelled", attempt: 1, req: get, err: context.Canceled},
+		{name: "retry-after", attempt: 1, req: get, resp: &http.Response{StatusCode: 429, Header: http.Header{"Retry-After": {"3"}}}, retry: true, min: 3 * time.Second, max: 3 * time.Second},
+		{name: "retry-after-capped", attempt: 1, req: get, resp: &http.Response{StatusCode: 503, Header: http.Header{"Retry-After": {"120"}}}, retry: true, min: 4 * time.Second, max: 4 * time.Second},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.resp != nil && test.resp.Header == nil {
+				test.resp.Header = http.Header{}
+			}
+			wait, retry := p.Retry(test.attempt, test.req, test.resp, test.err)
+			assert.Equal(t, test.retry, retry)
+			if test.retry {
+				assert.True(t, wait >= test.min && wait <= test.max, "wait %s not in [%s, %s]", wait, test.min, test.max)
+			}
+		})
+	}
+}
+
+func testParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("10")
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.True(t, wait > 59*time.Minute)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+}
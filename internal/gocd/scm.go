@@ -0,0 +1,87 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// SCMsService exposes calls for interacting with pluggable SCMs, which are used as `plugin` materials by pipelines.
+// Note that pluggable SCMs are addressed by their name rather than their id.
+type SCMsService service
+
+// SCMsListResponse describes the structure of the API response when listing pluggable SCMs
+type SCMsListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		SCMs []*SCM `json:"scms"`
+	} `json:"_embedded,omitempty"`
+}
+
+// SCM describes a pluggable SCM
+type SCM struct {
+	ID             string                         `json:"id,omitempty"`
+	Name           string                         `json:"name"`
+	AutoUpdate     bool                           `json:"auto_update"`
+	PluginMetadata *PluginMetadata                `json:"plugin_metadata"`
+	Configuration  []*PluginConfigurationProperty `json:"configuration,omitempty"`
+	Links          *HALLinks                      `json:"_links,omitempty"`
+	Version        string                         `json:"version,omitempty"`
+}
+
+// List all pluggable SCMs
+func (ss *SCMsService) List(ctx context.Context) (scms []*SCM, resp *APIResponse, err error) {
+	r := &SCMsListResponse{}
+	_, resp, err = ss.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/scms",
+		APIVersion:   apiV4,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.SCMs, resp, err
+}
+
+// Get a pluggable SCM by name
+func (ss *SCMsService) Get(ctx context.Context, name string) (scm *SCM, resp *APIResponse, err error) {
+	scm = &SCM{}
+	_, resp, err = ss.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/scms/%s", name),
+		APIVersion:   apiV4,
+		ResponseBody: scm,
+	})
+
+	return
+}
+
+// Create a pluggable SCM
+func (ss *SCMsService) Create(ctx context.Context, s *SCM) (scm *SCM, resp *APIResponse, err error) {
+	scm = &SCM{}
+	_, resp, err = ss.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/scms",
+		APIVersion:   apiV4,
+		RequestBody:  s,
+		ResponseBody: scm,
+	})
+
+	return
+}
+
+// Update a pluggable SCM by name. The version of the SCM must be set for the update to succeed.
+func (ss *SCMsService) Update(ctx context.Context, name string, s *SCM) (scm *SCM, resp *APIResponse, err error) {
+	scm = &SCM{}
+	_, resp, err = ss.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/scms/%s", name),
+		APIVersion:   apiV4,
+		RequestBody:  s,
+		ResponseBody: scm,
+	})
+
+	return
+}
+
+// Delete a pluggable SCM by name. Note: The SCM must not be used as a material by any pipeline.
+func (ss *SCMsService) Delete(ctx context.Context, name string) (string, *APIResponse, error) {
+	return ss.client.deleteAction(ctx, fmt.Sprintf("admin/scms/%s", name), apiV4)
+}
@@ -0,0 +1,143 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSCMsService(t *testing.T) {
+	t.Run("List", testSCMsServiceList)
+	t.Run("Get", testSCMsServiceGet)
+	t.Run("Create", testSCMsServiceCreate)
+	t.Run("Update", testSCMsServiceUpdate)
+	t.Run("Delete", testSCMsServiceDelete)
+}
+
+func testSCMsServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/scms", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV4, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/scms.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	scms, _, err := client.SCMs.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, scms, 1)
+	testSCM(t, scms[0])
+}
+
+func testSCMsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/scms/app-pull-requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV4, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/scm.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	scm, _, err := client.SCMs.Get(context.Background(), "app-pull-requests")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", scm.Version)
+	testSCM(t, scm)
+}
+
+func testSCMsServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/scms", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "name": "app-pull-requests",
+  "auto_update": true,
+  "plugin_metadata": {"id": "github.pr", "version": "1"},
+  "configuration": [
+    {"key": "url", "value": "https://github.com/example/app.git"},
+    {"key": "password", "value": "secret"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/scm.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	scm, _, err := client.SCMs.Create(context.Background(), &SCM{
+		Name:           "app-pull-requests",
+		AutoUpdate:     true,
+		PluginMetadata: &PluginMetadata{ID: "github.pr", Version: "1"},
+		Configuration: []*PluginConfigurationProperty{
+			{Key: "url", Value: "https://github.com/example/app.git"},
+			{Key: "password", Value: "secret"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testSCM(t, scm)
+}
+
+func testSCMsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/scms/app-pull-requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/scm.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	scm, _, err := client.SCMs.Update(context.Background(), "app-pull-requests", &SCM{
+		ID:             "2ebfbf2c-5c80-4a43-8c36-e3d4b5c1a5a1",
+		Name:           "app-pull-requests",
+		PluginMetadata: &PluginMetadata{ID: "github.pr", Version: "1"},
+		Version:        "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", scm.Version)
+	testSCM(t, scm)
+}
+
+func testSCMsServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/scms/app-pull-requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV4, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The scm 'app-pull-requests' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.SCMs.Delete(context.Background(), "app-pull-requests")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The scm 'app-pull-requests' was deleted successfully.", message)
+}
+
+func testSCM(t *testing.T, scm *SCM) {
+	assert.Equal(t, "2ebfbf2c-5c80-4a43-8c36-e3d4b5c1a5a1", scm.ID)
+	assert.Equal(t, "app-pull-requests", scm.Name)
+	assert.True(t, scm.AutoUpdate)
+	assert.Equal(t, &PluginMetadata{ID: "github.pr", Version: "1"}, scm.PluginMetadata)
+	assert.Len(t, scm.Configuration, 2)
+	assert.Equal(t, "https://github.com/example/app.git", scm.Configuration[0].Value)
+	assert.Equal(t, "password", scm.Configuration[1].Key)
+	assert.Equal(t, "AES:P6yrxImHpCCHe2Ww3qsdOw==:hVFBCr2kPUwKfgDq8UqzNw==", scm.Configuration[1].EncryptedValue)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/scms/app-pull-requests", scm.Links.Get("Self").URL.String())
+}
@@ -0,0 +1,96 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretConfigsService exposes calls for interacting with secret configurations, which configure the secret plugins
+// used to resolve `{{SECRET:[secret_config_id][key]}}` references.
+type SecretConfigsService service
+
+// SecretConfigsListResponse describes the structure of the API response when listing secret configurations
+type SecretConfigsListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		SecretConfigs []*SecretConfig `json:"secret_configs"`
+	} `json:"_embedded,omitempty"`
+}
+
+// SecretConfig describes a secret configuration
+type SecretConfig struct {
+	ID          string                         `json:"id"`
+	PluginID    string                         `json:"plugin_id"`
+	Description string                         `json:"description,omitempty"`
+	Properties  []*PluginConfigurationProperty `json:"properties,omitempty"`
+	Rules       []*Rule                        `json:"rules"`
+	Links       *HALLinks                      `json:"_links,omitempty"`
+	Version     string                         `json:"version,omitempty"`
+}
+
+// Rule describes which GoCD entities may make use of a configuration, eg which pipeline groups may refer to the
+// secrets of a secret configuration. Rules are evaluated in order, and the first matching rule applies.
+type Rule struct {
+	Directive string `json:"directive"`
+	Action    string `json:"action"`
+	Type      string `json:"type"`
+	Resource  string `json:"resource"`
+}
+
+// List all secret configurations
+func (scs *SecretConfigsService) List(ctx context.Context) (configs []*SecretConfig, resp *APIResponse, err error) {
+	r := &SecretConfigsListResponse{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/secret_configs",
+		APIVersion:   apiV3,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.SecretConfigs, resp, err
+}
+
+// Get a secret configuration by id
+func (scs *SecretConfigsService) Get(ctx context.Context, id string) (config *SecretConfig, resp *APIResponse, err error) {
+	config = &SecretConfig{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/secret_configs/%s", id),
+		APIVersion:   apiV3,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Create a secret configuration
+func (scs *SecretConfigsService) Create(ctx context.Context, sc *SecretConfig) (config *SecretConfig, resp *APIResponse, err error) {
+	config = &SecretConfig{}
+	_, resp, err = scs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/secret_configs",
+		APIVersion:   apiV3,
+		RequestBody:  sc,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Update a secret configuration. The version of the configuration must be set for the update to succeed.
+func (scs *SecretConfigsService) Update(ctx context.Context, id string, sc *SecretConfig) (config *SecretConfig, resp *APIResponse, err error) {
+	config = &SecretConfig{}
+	_, resp, err = scs.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/secret_configs/%s", id),
+		APIVersion:   apiV3,
+		RequestBody:  sc,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Delete a secret configuration
+func (scs *SecretConfigsService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return scs.client.deleteAction(ctx, fmt.Sprintf("admin/secret_configs/%s", id), apiV3)
+}
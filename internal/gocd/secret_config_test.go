@@ -0,0 +1,145 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSecretConfigsService(t *testing.T) {
+	t.Run("List", testSecretConfigsServiceList)
+	t.Run("Get", testSecretConfigsServiceGet)
+	t.Run("Create", testSecretConfigsServiceCreate)
+	t.Run("Update", testSecretConfigsServiceUpdate)
+	t.Run("Delete", testSecretConfigsServiceDelete)
+}
+
+func testSecretConfigsServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/secret_configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/secretconfigs.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	configs, _, err := client.SecretConfigs.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	testSecretConfig(t, configs[0])
+}
+
+func testSecretConfigsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/secret_configs/demo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/secretconfig.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecretConfigs.Get(context.Background(), "demo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testSecretConfig(t, config)
+}
+
+func testSecretConfigsServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/secret_configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "demo",
+  "plugin_id": "cd.go.secrets.file-based-plugin",
+  "properties": [
+    {"key": "SecretsFilePath", "value": "/godata/secrets/deploy.json"}
+  ],
+  "rules": [
+    {"directive": "allow", "action": "refer", "type": "pipeline_group", "resource": "deploy-*"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/secretconfig.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecretConfigs.Create(context.Background(), &SecretConfig{
+		ID:       "demo",
+		PluginID: "cd.go.secrets.file-based-plugin",
+		Properties: []*PluginConfigurationProperty{
+			{Key: "SecretsFilePath", Value: "/godata/secrets/deploy.json"},
+		},
+		Rules: []*Rule{
+			{Directive: "allow", Action: "refer", Type: "pipeline_group", Resource: "deploy-*"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testSecretConfig(t, config)
+}
+
+func testSecretConfigsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/secret_configs/demo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/secretconfig.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecretConfigs.Update(context.Background(), "demo", &SecretConfig{
+		ID:       "demo",
+		PluginID: "cd.go.secrets.file-based-plugin",
+		Version:  "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testSecretConfig(t, config)
+}
+
+func testSecretConfigsServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/secret_configs/demo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The secret config 'demo' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.SecretConfigs.Delete(context.Background(), "demo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The secret config 'demo' was deleted successfully.", message)
+}
+
+func testSecretConfig(t *testing.T, config *SecretConfig) {
+	assert.Equal(t, "demo", config.ID)
+	assert.Equal(t, "cd.go.secrets.file-based-plugin", config.PluginID)
+	assert.Equal(t, "Secrets for the deployment pipelines", config.Description)
+	assert.Len(t, config.Properties, 1)
+	assert.Equal(t, "SecretsFilePath", config.Properties[0].Key)
+	assert.Equal(t, []*Rule{
+		{Directive: "allow", Action: "refer", Type: "pipeline_group", Resource: "deploy-*"},
+		{Directive: "deny", Action: "refer", Type: "environment", Resource: "*"},
+	}, config.Rules)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/secret_configs/demo", config.Links.Get("Self").URL.String())
+}
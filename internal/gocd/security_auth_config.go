@@ -0,0 +1,108 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecurityAuthConfigsService exposes calls for interacting with authorization configurations, which configure the
+// authorization plugins used to log in to GoCD.
+type SecurityAuthConfigsService service
+
+// AuthConfigsListResponse describes the structure of the API response when listing authorization configurations
+type AuthConfigsListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		AuthConfigs []*AuthConfig `json:"auth_configs"`
+	} `json:"_embedded,omitempty"`
+}
+
+// AuthConfig describes an authorization configuration
+type AuthConfig struct {
+	ID                         string                         `json:"id"`
+	PluginID                   string                         `json:"plugin_id"`
+	AllowOnlyKnownUsersToLogin bool                           `json:"allow_only_known_users_to_login"`
+	Properties                 []*PluginConfigurationProperty `json:"properties,omitempty"`
+	Links                      *HALLinks                      `json:"_links,omitempty"`
+	Version                    string                         `json:"version,omitempty"`
+}
+
+// AuthConfigVerifyConnectionResponse describes the result of checking that an authorization plugin can connect to its
+// backend, eg an LDAP server, with a given authorization configuration.
+type AuthConfigVerifyConnectionResponse struct {
+	Status     string      `json:"status"`
+	Message    string      `json:"message"`
+	AuthConfig *AuthConfig `json:"auth_config,omitempty"`
+}
+
+// List all authorization configurations
+func (sacs *SecurityAuthConfigsService) List(ctx context.Context) (configs []*AuthConfig, resp *APIResponse, err error) {
+	r := &AuthConfigsListResponse{}
+	_, resp, err = sacs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/security/auth_configs",
+		APIVersion:   apiV2,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.AuthConfigs, resp, err
+}
+
+// Get an authorization configuration by id
+func (sacs *SecurityAuthConfigsService) Get(ctx context.Context, id string) (config *AuthConfig, resp *APIResponse, err error) {
+	config = &AuthConfig{}
+	_, resp, err = sacs.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/security/auth_configs/%s", id),
+		APIVersion:   apiV2,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Create an authorization configuration
+func (sacs *SecurityAuthConfigsService) Create(ctx context.Context, ac *AuthConfig) (config *AuthConfig, resp *APIResponse, err error) {
+	config = &AuthConfig{}
+	_, resp, err = sacs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/security/auth_configs",
+		APIVersion:   apiV2,
+		RequestBody:  ac,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Update an authorization configuration. The version of the configuration must be set for the update to succeed.
+func (sacs *SecurityAuthConfigsService) Update(ctx context.Context, id string, ac *AuthConfig) (config *AuthConfig, resp *APIResponse, err error) {
+	config = &AuthConfig{}
+	_, resp, err = sacs.client.putAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("admin/security/auth_configs/%s", id),
+		APIVersion:   apiV2,
+		RequestBody:  ac,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// Delete an authorization configuration. Note: The configuration must not be referenced by any role.
+func (sacs *SecurityAuthConfigsService) Delete(ctx context.Context, id string) (string, *APIResponse, error) {
+	return sacs.client.deleteAction(ctx, fmt.Sprintf("admin/security/auth_configs/%s", id), apiV2)
+}
+
+// VerifyConnection asks the authorization plugin to check the configuration against its backend without saving it.
+// A failed verification is returned as an error, with the reason given by the plugin in the response.
+func (sacs *SecurityAuthConfigsService) VerifyConnection(ctx context.Context, ac *AuthConfig) (result *AuthConfigVerifyConnectionResponse, resp *APIResponse, err error) {
+	result = &AuthConfigVerifyConnectionResponse{}
+	_, resp, err = sacs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/internal/security/auth_configs/verify_connection",
+		APIVersion:   apiV2,
+		RequestBody:  ac,
+		ResponseBody: result,
+	})
+
+	return
+}
@@ -0,0 +1,181 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSecurityAuthConfigsService(t *testing.T) {
+	t.Run("List", testSecurityAuthConfigsServiceList)
+	t.Run("Get", testSecurityAuthConfigsServiceGet)
+	t.Run("Create", testSecurityAuthConfigsServiceCreate)
+	t.Run("Update", testSecurityAuthConfigsServiceUpdate)
+	t.Run("Delete", testSecurityAuthConfigsServiceDelete)
+	t.Run("VerifyConnection", testSecurityAuthConfigsServiceVerifyConnection)
+	t.Run("VerifyConnectionFail", testSecurityAuthConfigsServiceVerifyConnectionFail)
+}
+
+func testSecurityAuthConfigsServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/auth_configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/authconfigs.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	configs, _, err := client.SecurityAuthConfigs.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, configs, 1)
+	testAuthConfig(t, configs[0])
+}
+
+func testSecurityAuthConfigsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/auth_configs/ldap", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/authconfig.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecurityAuthConfigs.Get(context.Background(), "ldap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testAuthConfig(t, config)
+}
+
+func testSecurityAuthConfigsServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/auth_configs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "id": "ldap",
+  "plugin_id": "cd.go.authentication.ldap",
+  "allow_only_known_users_to_login": true,
+  "properties": [
+    {"key": "Url", "value": "ldap://ldap.example.com"}
+  ]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/authconfig.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecurityAuthConfigs.Create(context.Background(), &AuthConfig{
+		ID:                         "ldap",
+		PluginID:                   "cd.go.authentication.ldap",
+		AllowOnlyKnownUsersToLogin: true,
+		Properties: []*PluginConfigurationProperty{
+			{Key: "Url", Value: "ldap://ldap.example.com"},
+		},
+	})
+
+	assert.Nil(t, err)
+	testAuthConfig(t, config)
+}
+
+func testSecurityAuthConfigsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/auth_configs/ldap", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		j, _ := ioutil.ReadFile("test/resources/authconfig.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.SecurityAuthConfigs.Update(context.Background(), "ldap", &AuthConfig{
+		ID:       "ldap",
+		PluginID: "cd.go.authentication.ldap",
+		Version:  "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testAuthConfig(t, config)
+}
+
+func testSecurityAuthConfigsServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/auth_configs/ldap", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "The security auth config 'ldap' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.SecurityAuthConfigs.Delete(context.Background(), "ldap")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "The security auth config 'ldap' was deleted successfully.", message)
+}
+
+func testSecurityAuthConfigsServiceVerifyConnection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/internal/security/auth_configs/verify_connection", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/authconfig.0.json")
+		fmt.Fprintf(w, `{"status": "success", "message": "Connection ok", "auth_config": %s}`, string(j))
+	})
+
+	result, _, err := client.SecurityAuthConfigs.VerifyConnection(context.Background(), &AuthConfig{
+		ID:       "ldap",
+		PluginID: "cd.go.authentication.ldap",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "success", result.Status)
+	assert.Equal(t, "Connection ok", result.Message)
+	testAuthConfig(t, result.AuthConfig)
+}
+
+func testSecurityAuthConfigsServiceVerifyConnectionFail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/internal/security/auth_configs/verify_connection", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status": "failure", "message": "Could not connect to ldap://ldap.example.com"}`)
+	})
+
+	result, _, err := client.SecurityAuthConfigs.VerifyConnection(context.Background(), &AuthConfig{
+		ID:       "ldap",
+		PluginID: "cd.go.authentication.ldap",
+	})
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Could not connect to ldap://ldap.example.com")
+	assert.Equal(t, "failure", result.Status)
+}
+
+func testAuthConfig(t *testing.T, config *AuthConfig) {
+	assert.Equal(t, "ldap", config.ID)
+	assert.Equal(t, "cd.go.authentication.ldap", config.PluginID)
+	assert.True(t, config.AllowOnlyKnownUsersToLogin)
+	assert.Len(t, config.Properties, 3)
+	assert.Equal(t, "Password", config.Properties[2].Key)
+	assert.Equal(t, "AES:Wy7r5R4lXmtIvOk2P7rJhg==:2hx1oXm0ZAbH7aNvwh3SjQ==", config.Properties[2].EncryptedValue)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/security/auth_configs/ldap", config.Links.Get("Self").URL.String())
+}
@@ -0,0 +1,159 @@
+package gocd
+
+import (
+	"context"
+)
+
+// ServerConfigurationService exposes calls for interacting with the server wide configuration, such as the site URLs,
+// the artifact storage, the default job timeout and the mail server.
+type ServerConfigurationService service
+
+// SiteURLs describes the URLs used by the GoCD server to generate links to itself
+type SiteURLs struct {
+	SiteURL       string    `json:"site_url,omitempty"`
+	SecureSiteURL string    `json:"secure_site_url,omitempty"`
+	Links         *HALLinks `json:"_links,omitempty"`
+}
+
+// ArtifactConfig describes where artifacts are stored and when they are purged
+type ArtifactConfig struct {
+	ArtifactsDir  string                 `json:"artifacts_dir"`
+	PurgeSettings *ArtifactPurgeSettings `json:"purge_settings,omitempty"`
+	Links         *HALLinks              `json:"_links,omitempty"`
+	Version       string                 `json:"version,omitempty"`
+}
+
+// ArtifactPurgeSettings describes the free disk space, in GB, at which purging of old artifacts starts and stops
+type ArtifactPurgeSettings struct {
+	PurgeStartDiskSpace float64 `json:"purge_start_disk_space,omitempty"`
+	PurgeUptoDiskSpace  float64 `json:"purge_upto_disk_space,omitempty"`
+}
+
+// DefaultJobTimeout describes the number of minutes after which hung jobs are cancelled. A timeout of "0" never cancels
+// jobs.
+type DefaultJobTimeout struct {
+	DefaultJobTimeout string    `json:"default_job_timeout"`
+	Links             *HALLinks `json:"_links,omitempty"`
+}
+
+// MailServer describes the SMTP server used to send notifications
+// codebeat:disable[TOO_MANY_IVARS]
+type MailServer struct {
+	Hostname          string    `json:"hostname"`
+	Port              int       `json:"port"`
+	Username          string    `json:"username,omitempty"`
+	Password          string    `json:"password,omitempty"`
+	EncryptedPassword string    `json:"encrypted_password,omitempty"`
+	TLS               bool      `json:"tls"`
+	SenderEmail       string    `json:"sender_email"`
+	AdminEmail        string    `json:"admin_email"`
+	Links             *HALLinks `json:"_links,omitempty"`
+}
+
+// codebeat:enable[TOO_MANY_IVARS]
+
+// GetSiteURLs retrieves the site URLs of the server
+func (scs *ServerConfigurationService) GetSiteURLs(ctx context.Context) (urls *SiteURLs, resp *APIResponse, err error) {
+	urls = &SiteURLs{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/site_urls",
+		APIVersion:   apiV1,
+		ResponseBody: urls,
+	})
+
+	return
+}
+
+// UpdateSiteURLs replaces the site URLs of the server
+func (scs *ServerConfigurationService) UpdateSiteURLs(ctx context.Context, su *SiteURLs) (urls *SiteURLs, resp *APIResponse, err error) {
+	urls = &SiteURLs{}
+	_, resp, err = scs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/site_urls",
+		APIVersion:   apiV1,
+		RequestBody:  su,
+		ResponseBody: urls,
+	})
+
+	return
+}
+
+// GetArtifactConfig retrieves the artifact storage configuration of the server
+func (scs *ServerConfigurationService) GetArtifactConfig(ctx context.Context) (config *ArtifactConfig, resp *APIResponse, err error) {
+	config = &ArtifactConfig{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/artifact_config",
+		APIVersion:   apiV1,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// UpdateArtifactConfig replaces the artifact storage configuration of the server. The version of the configuration
+// must be set for the update to succeed.
+func (scs *ServerConfigurationService) UpdateArtifactConfig(ctx context.Context, ac *ArtifactConfig) (config *ArtifactConfig, resp *APIResponse, err error) {
+	config = &ArtifactConfig{}
+	_, resp, err = scs.client.putAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/artifact_config",
+		APIVersion:   apiV1,
+		RequestBody:  ac,
+		ResponseBody: config,
+	})
+
+	return
+}
+
+// GetDefaultJobTimeout retrieves the default job timeout of the server
+func (scs *ServerConfigurationService) GetDefaultJobTimeout(ctx context.Context) (timeout *DefaultJobTimeout, resp *APIResponse, err error) {
+	timeout = &DefaultJobTimeout{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/default_job_timeout",
+		APIVersion:   apiV1,
+		ResponseBody: timeout,
+	})
+
+	return
+}
+
+// UpdateDefaultJobTimeout replaces the default job timeout of the server
+func (scs *ServerConfigurationService) UpdateDefaultJobTimeout(ctx context.Context, djt *DefaultJobTimeout) (timeout *DefaultJobTimeout, resp *APIResponse, err error) {
+	timeout = &DefaultJobTimeout{}
+	_, resp, err = scs.client.postAction(ctx, &APIClientRequest{
+		Path:         "admin/config/server/default_job_timeout",
+		APIVersion:   apiV1,
+		RequestBody:  djt,
+		ResponseBody: timeout,
+	})
+
+	return
+}
+
+// GetMailServer retrieves the mail server configuration. A 404 is returned if no mail server is configured.
+func (scs *ServerConfigurationService) GetMailServer(ctx context.Context) (server *MailServer, resp *APIResponse, err error) {
+	server = &MailServer{}
+	_, resp, err = scs.client.getAction(ctx, &APIClientRequest{
+		Path:         "config/mailserver",
+		APIVersion:   apiV1,
+		ResponseBody: server,
+	})
+
+	return
+}
+
+// UpdateMailServer creates or replaces the mail server configuration
+func (scs *ServerConfigurationService) UpdateMailServer(ctx context.Context, ms *MailServer) (server *MailServer, resp *APIResponse, err error) {
+	server = &MailServer{}
+	_, resp, err = scs.client.postAction(ctx, &APIClientRequest{
+		Path:         "config/mailserver",
+		APIVersion:   apiV1,
+		RequestBody:  ms,
+		ResponseBody: server,
+	})
+
+	return
+}
+
+// DeleteMailServer removes the mail server configuration
+func (scs *ServerConfigurationService) DeleteMailServer(ctx context.Context) (string, *APIResponse, error) {
+	return scs.client.deleteAction(ctx, "config/mailserver", apiV1)
+}
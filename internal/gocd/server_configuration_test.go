@@ -0,0 +1,243 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServerConfigurationService(t *testing.T) {
+	t.Run("GetSiteURLs", testServerConfigurationServiceGetSiteURLs)
+	t.Run("UpdateSiteURLs", testServerConfigurationServiceUpdateSiteURLs)
+	t.Run("GetArtifactConfig", testServerConfigurationServiceGetArtifactConfig)
+	t.Run("UpdateArtifactConfig", testServerConfigurationServiceUpdateArtifactConfig)
+	t.Run("GetDefaultJobTimeout", testServerConfigurationServiceGetDefaultJobTimeout)
+	t.Run("UpdateDefaultJobTimeout", testServerConfigurationServiceUpdateDefaultJobTimeout)
+	t.Run("GetMailServer", testServerConfigurationServiceGetMailServer)
+	t.Run("UpdateMailServer", testServerConfigurationServiceUpdateMailServer)
+	t.Run("DeleteMailServer", testServerConfigurationServiceDeleteMailServer)
+}
+
+func testServerConfigurationServiceGetSiteURLs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/site_urls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/siteurls.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	urls, _, err := client.ServerConfiguration.GetSiteURLs(context.Background())
+
+	assert.Nil(t, err)
+	testSiteURLs(t, urls)
+}
+
+func testServerConfigurationServiceUpdateSiteURLs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/site_urls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "site_url": "http://ci.example.com/go",
+  "secure_site_url": "https://ci.example.com/go"
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/siteurls.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	urls, _, err := client.ServerConfiguration.UpdateSiteURLs(context.Background(), &SiteURLs{
+		SiteURL:       "http://ci.example.com/go",
+		SecureSiteURL: "https://ci.example.com/go",
+	})
+
+	assert.Nil(t, err)
+	testSiteURLs(t, urls)
+}
+
+func testServerConfigurationServiceGetArtifactConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/artifact_config", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/artifactconfig.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.ServerConfiguration.GetArtifactConfig(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testArtifactConfig(t, config)
+}
+
+func testServerConfigurationServiceUpdateArtifactConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/artifact_config", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "artifacts_dir": "artifacts",
+  "purge_settings": {"purge_start_disk_space": 10, "purge_upto_disk_space": 20},
+  "version": "test-version"
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/artifactconfig.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	config, _, err := client.ServerConfiguration.UpdateArtifactConfig(context.Background(), &ArtifactConfig{
+		ArtifactsDir: "artifacts",
+		PurgeSettings: &ArtifactPurgeSettings{
+			PurgeStartDiskSpace: 10,
+			PurgeUptoDiskSpace:  20,
+		},
+		Version: "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", config.Version)
+	testArtifactConfig(t, config)
+}
+
+func testServerConfigurationServiceGetDefaultJobTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/default_job_timeout", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/defaultjobtimeout.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	timeout, _, err := client.ServerConfiguration.GetDefaultJobTimeout(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "30", timeout.DefaultJobTimeout)
+}
+
+func testServerConfigurationServiceUpdateDefaultJobTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/config/server/default_job_timeout", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"default_job_timeout": "30"}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/defaultjobtimeout.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	timeout, _, err := client.ServerConfiguration.UpdateDefaultJobTimeout(context.Background(), &DefaultJobTimeout{
+		DefaultJobTimeout: "30",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "30", timeout.DefaultJobTimeout)
+}
+
+func testServerConfigurationServiceGetMailServer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/config/mailserver", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/mailserver.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	server, _, err := client.ServerConfiguration.GetMailServer(context.Background())
+
+	assert.Nil(t, err)
+	testMailServer(t, server)
+}
+
+func testServerConfigurationServiceUpdateMailServer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/config/mailserver", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "hostname": "smtp.example.com",
+  "port": 465,
+  "username": "gocd",
+  "password": "secret",
+  "tls": true,
+  "sender_email": "gocd@example.com",
+  "admin_email": "admin@example.com"
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/mailserver.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	server, _, err := client.ServerConfiguration.UpdateMailServer(context.Background(), &MailServer{
+		Hostname:    "smtp.example.com",
+		Port:        465,
+		Username:    "gocd",
+		Password:    "secret",
+		TLS:         true,
+		SenderEmail: "gocd@example.com",
+		AdminEmail:  "admin@example.com",
+	})
+
+	assert.Nil(t, err)
+	testMailServer(t, server)
+}
+
+func testServerConfigurationServiceDeleteMailServer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/config/mailserver", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV1, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "Mail server config was deleted successfully!"
+}`)
+	})
+
+	message, _, err := client.ServerConfiguration.DeleteMailServer(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Mail server config was deleted successfully!", message)
+}
+
+func testSiteURLs(t *testing.T, urls *SiteURLs) {
+	assert.Equal(t, "http://ci.example.com/go", urls.SiteURL)
+	assert.Equal(t, "https://ci.example.com/go", urls.SecureSiteURL)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/config/server/site_urls", urls.Links.Get("Self").URL.String())
+}
+
+func testArtifactConfig(t *testing.T, config *ArtifactConfig) {
+	assert.Equal(t, "artifacts", config.ArtifactsDir)
+	assert.Equal(t, float64(10), config.PurgeSettings.PurgeStartDiskSpace)
+	assert.Equal(t, float64(20), config.PurgeSettings.PurgeUptoDiskSpace)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/config/server/artifact_config", config.Links.Get("Self").URL.String())
+}
+
+func testMailServer(t *testing.T, server *MailServer) {
+	assert.Equal(t, "smtp.example.com", server.Hostname)
+	assert.Equal(t, 465, server.Port)
+	assert.Equal(t, "gocd", server.Username)
+	assert.Equal(t, "AES:lzcCuNSe4vUx+CsWgN11Uw==:Ebq9tuxmWJgv2p3UEaXsYg==", server.EncryptedPassword)
+	assert.True(t, server.TLS)
+	assert.Equal(t, "gocd@example.com", server.SenderEmail)
+	assert.Equal(t, "admin@example.com", server.AdminEmail)
+}
@@ -3,12 +3,16 @@ package gocd
 import (
 	"context"
 	"github.com/hashicorp/go-version"
+	"sync"
 )
 
 // ServerVersionService exposes calls for interacting with ServerVersion objects in the GoCD API.
 type ServerVersionService service
 
-var cachedServerVersion *ServerVersion
+var (
+	cachedServerVersion   *ServerVersion
+	cachedServerVersionMu sync.RWMutex // cachedServerVersionMu protects cachedServerVersion between concurrent calls
+)
 
 // ServerVersion of the GoCD installation
 type ServerVersion struct {
@@ -22,8 +26,11 @@ type ServerVersion struct {
 
 // Get retrieves information about a specific plugin.
 func (svs *ServerVersionService) Get(ctx context.Context) (v *ServerVersion, resp *APIResponse, err error) {
-	if cachedServerVersion != nil {
-		return cachedServerVersion, nil, nil
+	cachedServerVersionMu.RLock()
+	cached := cachedServerVersion
+	cachedServerVersionMu.RUnlock()
+	if cached != nil {
+		return cached, nil, nil
 	}
 
 	v = &ServerVersion{}
@@ -37,7 +44,9 @@ func (svs *ServerVersionService) Get(ctx context.Context) (v *ServerVersion, res
 
 	err = v.parseVersion()
 
+	cachedServerVersionMu.Lock()
 	cachedServerVersion = v
+	cachedServerVersionMu.Unlock()
 
 	return
 }
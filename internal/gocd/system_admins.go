@@ -0,0 +1,42 @@
+package gocd
+
+import (
+	"context"
+)
+
+// SystemAdminsService exposes calls for interacting with the users and roles which administer the GoCD server.
+type SystemAdminsService service
+
+// SystemAdmins describes the users and roles with system admin permissions. Note: When no users or roles are system
+// admins, GoCD treats every user as a system admin.
+type SystemAdmins struct {
+	Roles   []string  `json:"roles"`
+	Users   []string  `json:"users"`
+	Links   *HALLinks `json:"_links,omitempty"`
+	Version string    `json:"version,omitempty"`
+}
+
+// Get the system admins
+func (sas *SystemAdminsService) Get(ctx context.Context) (admins *SystemAdmins, resp *APIResponse, err error) {
+	admins = &SystemAdmins{}
+	_, resp, err = sas.client.getAction(ctx, &APIClientRequest{
+		Path:         "admin/security/system_admins",
+		APIVersion:   apiV2,
+		ResponseBody: admins,
+	})
+
+	return
+}
+
+// Update replaces the system admins. The version of the system admins must be set for the update to succeed.
+func (sas *SystemAdminsService) Update(ctx context.Context, sa *SystemAdmins) (admins *SystemAdmins, resp *APIResponse, err error) {
+	admins = &SystemAdmins{}
+	_, resp, err = sas.client.putAction(ctx, &APIClientRequest{
+		Path:         "admin/security/system_admins",
+		APIVersion:   apiV2,
+		RequestBody:  sa,
+		ResponseBody: admins,
+	})
+
+	return
+}
@@ -0,0 +1,69 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSystemAdminsService(t *testing.T) {
+	t.Run("Get", testSystemAdminsServiceGet)
+	t.Run("Update", testSystemAdminsServiceUpdate)
+}
+
+func testSystemAdminsServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/system_admins", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV2, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/systemadmins.0.json")
+		w.Header().Set("Etag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	admins, _, err := client.SystemAdmins.Get(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", admins.Version)
+	testSystemAdmins(t, admins)
+}
+
+func testSystemAdminsServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/admin/security/system_admins", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, `"test-version"`, r.Header.Get("If-Match"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "roles": ["admins"],
+  "users": ["jdoe"],
+  "version": "test-version"
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/systemadmins.0.json")
+		w.Header().Set("ETag", `"mock-version"`)
+		fmt.Fprint(w, string(j))
+	})
+
+	admins, _, err := client.SystemAdmins.Update(context.Background(), &SystemAdmins{
+		Roles:   []string{"admins"},
+		Users:   []string{"jdoe"},
+		Version: "test-version",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-version", admins.Version)
+	testSystemAdmins(t, admins)
+}
+
+func testSystemAdmins(t *testing.T, admins *SystemAdmins) {
+	assert.Equal(t, []string{"admins"}, admins.Roles)
+	assert.Equal(t, []string{"jdoe"}, admins.Users)
+	assert.Equal(t, "https://ci.example.com/go/api/admin/security/system_admins", admins.Links.Get("Self").URL.String())
+}
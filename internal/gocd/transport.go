@@ -0,0 +1,84 @@
+package gocd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// HTTPTransport builds the transport used to reach the GoCD server from the TLS and proxy settings of the
+// configuration. The proxy is read from the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables when
+// ProxyURL is empty.
+func (c *Configuration) HTTPTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	transport.Proxy = http.ProxyFromEnvironment
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url '%s': %s", c.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// tlsConfig trusts the system certificates and the CA bundle of the configuration, and presents the client certificate
+// if there is one.
+func (c *Configuration) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: strings.HasPrefix(c.Server, "https") && c.SkipSslCheck,
+	}
+
+	caPEM := []byte(c.CACertPEM)
+	if c.CACertFile != "" {
+		b, err := ioutil.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificates: %s", err)
+		}
+		caPEM = append(caPEM, '\n')
+		caPEM = append(caPEM, b...)
+	}
+	if len(strings.TrimSpace(string(caPEM))) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no PEM encoded CA certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCert != "" || c.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// errorTransport fails every request with the error raised while building the transport of the client, as NewClient
+// has no way to return it.
+type errorTransport struct {
+	err error
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *errorTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
@@ -0,0 +1,183 @@
+package gocd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	t.Run("CACertPEM", testHTTPTransportCACertPEM)
+	t.Run("CACertFile", testHTTPTransportCACertFile)
+	t.Run("CACertInvalid", testHTTPTransportCACertInvalid)
+	t.Run("ClientCert", testHTTPTransportClientCert)
+	t.Run("ClientCertInvalid", testHTTPTransportClientCertInvalid)
+	t.Run("Proxy", testHTTPTransportProxy)
+	t.Run("Timeout", testHTTPTransportTimeout)
+}
+
+func newTLSVersionServer() *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		j, _ := ioutil.ReadFile("test/resources/version.1.json")
+		fmt.Fprint(w, string(j))
+	}))
+}
+
+func serverCertPEM(s *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}))
+}
+
+func getServerVersion(c *Client) error {
+	req, err := c.NewRequest("GET", "version", nil, apiV1)
+	if err != nil {
+		return err
+	}
+	_, err = c.Do(context.Background(), req, &ServerVersion{}, responseTypeJSON)
+	return err
+}
+
+func testHTTPTransportCACertPEM(t *testing.T) {
+	s := newTLSVersionServer()
+	defer s.Close()
+
+	assert.Error(t, getServerVersion(NewClient(&Configuration{Server: s.URL}, nil)))
+
+	c := NewClient(&Configuration{
+		Server:    s.URL,
+		CACertPEM: serverCertPEM(s),
+	}, nil)
+	assert.NoError(t, getServerVersion(c))
+}
+
+func testHTTPTransportCACertFile(t *testing.T) {
+	s := newTLSVersionServer()
+	defer s.Close()
+
+	f, err := ioutil.TempFile("", "gocd-ca-*.pem")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString(serverCertPEM(s))
+	f.Close()
+
+	c := NewClient(&Configuration{
+		Server:     s.URL,
+		CACertFile: f.Name(),
+	}, nil)
+	assert.NoError(t, getServerVersion(c))
+
+	_, err = (&Configuration{CACertFile: f.Name() + ".missing"}).HTTPTransport()
+	assert.Error(t, err)
+}
+
+func testHTTPTransportCACertInvalid(t *testing.T) {
+	_, err := (&Configuration{CACertPEM: "not a certificate"}).HTTPTransport()
+	assert.EqualError(t, err, "no PEM encoded CA certificates found")
+
+	// NewClient can not return the error, so it is returned by every request instead.
+	c := NewClient(&Configuration{Server: "https://ci.example.com/go", CACertPEM: "not a certificate"}, nil)
+	err = getServerVersion(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no PEM encoded CA certificates found")
+}
+
+func testHTTPTransportClientCert(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte(certPEM))
+
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		j, _ := ioutil.ReadFile("test/resources/version.1.json")
+		fmt.Fprint(w, string(j))
+	}))
+	s.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	s.StartTLS()
+	defer s.Close()
+
+	assert.Error(t, getServerVersion(NewClient(&Configuration{
+		Server:    s.URL,
+		CACertPEM: serverCertPEM(s),
+	}, nil)))
+
+	assert.NoError(t, getServerVersion(NewClient(&Configuration{
+		Server:     s.URL,
+		CACertPEM:  serverCertPEM(s),
+		ClientCert: certPEM,
+		ClientKey:  keyPEM,
+	}, nil)))
+}
+
+func testHTTPTransportClientCertInvalid(t *testing.T) {
+	certPEM, _ := newClientCertificate(t)
+
+	_, err := (&Configuration{ClientCert: certPEM}).HTTPTransport()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid client certificate")
+}
+
+func testHTTPTransportProxy(t *testing.T) {
+	transport, err := (&Configuration{ProxyURL: "http://proxy.example.com:3128"}).HTTPTransport()
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", "https://ci.example.com/go/api/version", nil)
+	proxyURL, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxyURL)
+
+	_, err = (&Configuration{ProxyURL: "://proxy"}).HTTPTransport()
+	assert.Error(t, err)
+}
+
+func testHTTPTransportTimeout(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer s.Close()
+
+	c := NewClient(&Configuration{Server: s.URL, Timeout: 20 * time.Millisecond}, nil)
+	assert.Equal(t, 20*time.Millisecond, c.client.Timeout)
+	assert.Error(t, getServerVersion(c))
+}
+
+// newClientCertificate generates a self signed client certificate and its key, PEM encoded.
+func newClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
@@ -0,0 +1,141 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+)
+
+// UsersService exposes calls for interacting with the users known to the GoCD server.
+type UsersService service
+
+// UsersListResponse describes the structure of the API response when listing users
+type UsersListResponse struct {
+	Links    *HALLinks `json:"_links,omitempty"`
+	Embedded *struct {
+		Users []*User `json:"users"`
+	} `json:"_embedded,omitempty"`
+}
+
+// User describes a user. The display name is provided by the authorization plugin the user logged in with, and the
+// admin flag and roles are read only.
+// codebeat:disable[TOO_MANY_IVARS]
+type User struct {
+	LoginName      string      `json:"login_name"`
+	DisplayName    string      `json:"display_name,omitempty"`
+	Enabled        bool        `json:"enabled"`
+	Email          string      `json:"email"`
+	EmailMe        bool        `json:"email_me"`
+	CheckinAliases []string    `json:"checkin_aliases"`
+	Admin          bool        `json:"is_admin,omitempty"`
+	Roles          []*UserRole `json:"roles,omitempty"`
+	Links          *HALLinks   `json:"_links,omitempty"`
+}
+
+// codebeat:enable[TOO_MANY_IVARS]
+
+// UserRole describes a role a user belongs to
+type UserRole struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// UsersBulkStateUpdate describes the request to enable or disable multiple users
+type UsersBulkStateUpdate struct {
+	Users      []string                 `json:"users"`
+	Operations UsersBulkStateOperations `json:"operations"`
+}
+
+// UsersBulkStateOperations describes the state users are changed to
+type UsersBulkStateOperations struct {
+	Enable bool `json:"enable"`
+}
+
+// UsersBulkDelete describes the request to delete multiple users
+type UsersBulkDelete struct {
+	Users []string `json:"users"`
+}
+
+// List all users
+func (us *UsersService) List(ctx context.Context) (users []*User, resp *APIResponse, err error) {
+	r := &UsersListResponse{}
+	_, resp, err = us.client.getAction(ctx, &APIClientRequest{
+		Path:         "users",
+		APIVersion:   apiV3,
+		ResponseBody: r,
+	})
+	if err != nil || r.Embedded == nil {
+		return
+	}
+
+	return r.Embedded.Users, resp, err
+}
+
+// Get a user by login name
+func (us *UsersService) Get(ctx context.Context, loginName string) (user *User, resp *APIResponse, err error) {
+	user = &User{}
+	_, resp, err = us.client.getAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("users/%s", loginName),
+		APIVersion:   apiV3,
+		ResponseBody: user,
+	})
+
+	return
+}
+
+// Create a user, so that a user can be given roles or be disabled before they first log in.
+func (us *UsersService) Create(ctx context.Context, u *User) (user *User, resp *APIResponse, err error) {
+	user = &User{}
+	_, resp, err = us.client.postAction(ctx, &APIClientRequest{
+		Path:         "users",
+		APIVersion:   apiV3,
+		RequestBody:  u,
+		ResponseBody: user,
+	})
+
+	return
+}
+
+// Update the state, email and checkin aliases of a user
+func (us *UsersService) Update(ctx context.Context, loginName string, u *User) (user *User, resp *APIResponse, err error) {
+	user = &User{}
+	_, resp, err = us.client.patchAction(ctx, &APIClientRequest{
+		Path:         fmt.Sprintf("users/%s", loginName),
+		APIVersion:   apiV3,
+		RequestBody:  u,
+		ResponseBody: user,
+	})
+
+	return
+}
+
+// Delete a user. Note: The user must be disabled to be deleted.
+func (us *UsersService) Delete(ctx context.Context, loginName string) (string, *APIResponse, error) {
+	return us.client.deleteAction(ctx, fmt.Sprintf("users/%s", loginName), apiV3)
+}
+
+// BulkUpdateState enables or disables multiple users in a single request.
+func (us *UsersService) BulkUpdateState(ctx context.Context, update UsersBulkStateUpdate) (message string, resp *APIResponse, err error) {
+	a := StringResponse{}
+	_, resp, err = us.client.patchAction(ctx, &APIClientRequest{
+		Path:         "users/operations/state",
+		APIVersion:   apiV3,
+		RequestBody:  update,
+		ResponseBody: &a,
+	})
+	message = a.Message
+	return
+}
+
+// BulkDelete deletes multiple users in a single request. Note: The users must be disabled to be deleted.
+func (us *UsersService) BulkDelete(ctx context.Context, loginNames []string) (message string, resp *APIResponse, err error) {
+	a := StringResponse{}
+	_, resp, err = us.client.httpAction(ctx, &APIClientRequest{
+		Method:       "DELETE",
+		Path:         "users",
+		APIVersion:   apiV3,
+		RequestBody:  &UsersBulkDelete{Users: loginNames},
+		ResponseBody: &a,
+	})
+	message = a.Message
+	return
+}
@@ -0,0 +1,189 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUsersService(t *testing.T) {
+	t.Run("List", testUsersServiceList)
+	t.Run("Get", testUsersServiceGet)
+	t.Run("Create", testUsersServiceCreate)
+	t.Run("Update", testUsersServiceUpdate)
+	t.Run("Delete", testUsersServiceDelete)
+	t.Run("BulkUpdateState", testUsersServiceBulkUpdateState)
+	t.Run("BulkDelete", testUsersServiceBulkDelete)
+}
+
+func testUsersServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/users.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	users, _, err := client.Users.List(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, users, 1)
+	testUser(t, users[0])
+}
+
+func testUsersServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users/jdoe", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		j, _ := ioutil.ReadFile("test/resources/user.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	user, _, err := client.Users.Get(context.Background(), "jdoe")
+
+	assert.Nil(t, err)
+	testUser(t, user)
+}
+
+func testUsersServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "login_name": "jdoe",
+  "enabled": true,
+  "email": "jdoe@example.com",
+  "email_me": true,
+  "checkin_aliases": ["jdoe", "jane.doe@example.com"]
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/user.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	user, _, err := client.Users.Create(context.Background(), &User{
+		LoginName:      "jdoe",
+		Enabled:        true,
+		Email:          "jdoe@example.com",
+		EmailMe:        true,
+		CheckinAliases: []string{"jdoe", "jane.doe@example.com"},
+	})
+
+	assert.Nil(t, err)
+	testUser(t, user)
+}
+
+func testUsersServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users/jdoe", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Unexpected HTTP method")
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "login_name": "jdoe",
+  "enabled": false,
+  "email": "",
+  "email_me": false,
+  "checkin_aliases": []
+}`, string(b))
+		j, _ := ioutil.ReadFile("test/resources/user.0.json")
+		fmt.Fprint(w, string(j))
+	})
+
+	user, _, err := client.Users.Update(context.Background(), "jdoe", &User{
+		LoginName:      "jdoe",
+		CheckinAliases: []string{},
+	})
+
+	assert.Nil(t, err)
+	testUser(t, user)
+}
+
+func testUsersServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users/jdoe", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		fmt.Fprint(w, `{
+  "message": "User 'jdoe' was deleted successfully."
+}`)
+	})
+
+	message, _, err := client.Users.Delete(context.Background(), "jdoe")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "User 'jdoe' was deleted successfully.", message)
+}
+
+func testUsersServiceBulkUpdateState(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users/operations/state", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+  "users": ["jdoe", "jsmith"],
+  "operations": {"enable": false}
+}`, string(b))
+		fmt.Fprint(w, `{
+  "message": "Users 'jdoe, jsmith' were disabled successfully."
+}`)
+	})
+
+	message, _, err := client.Users.BulkUpdateState(context.Background(), UsersBulkStateUpdate{
+		Users:      []string{"jdoe", "jsmith"},
+		Operations: UsersBulkStateOperations{Enable: false},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Users 'jdoe, jsmith' were disabled successfully.", message)
+}
+
+func testUsersServiceBulkDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Unexpected HTTP method")
+		assert.Equal(t, apiV3, r.Header.Get("Accept"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"users": ["jdoe", "jsmith"]}`, string(b))
+		fmt.Fprint(w, `{
+  "message": "Users 'jdoe, jsmith' were deleted successfully."
+}`)
+	})
+
+	message, _, err := client.Users.BulkDelete(context.Background(), []string{"jdoe", "jsmith"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Users 'jdoe, jsmith' were deleted successfully.", message)
+}
+
+func testUser(t *testing.T, user *User) {
+	assert.Equal(t, "jdoe", user.LoginName)
+	assert.Equal(t, "Jane Doe", user.DisplayName)
+	assert.True(t, user.Enabled)
+	assert.Equal(t, "jdoe@example.com", user.Email)
+	assert.True(t, user.EmailMe)
+	assert.Equal(t, []string{"jdoe", "jane.doe@example.com"}, user.CheckinAliases)
+	assert.False(t, user.Admin)
+	assert.Len(t, user.Roles, 1)
+	assert.Equal(t, "developers", user.Roles[0].Name)
+	assert.Equal(t, "https://ci.example.com/go/api/users/jdoe", user.Links.Get("Self").URL.String())
+}
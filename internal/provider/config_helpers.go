@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Give an abstract list of strings cast as []interface{}, convert them back to []string{}.
@@ -71,3 +73,47 @@ func RegexRuleset(rules RegexRules) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// validateCronSpec checks that a timer spec is a Quartz cron expression, as used by GoCD timers. The expression has
+// seconds, minutes, hours, day of month, month, day of week and an optional year, and `?` must be used for exactly one
+// of day of month or day of week.
+func validateCronSpec(i interface{}, key string) (s []string, errors []error) {
+	value, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q (%q) to be string", key, value))
+		return
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) != 6 && len(fields) != 7 {
+		errors = append(errors, fmt.Errorf("%q (%q) must have 6 or 7 fields, starting with seconds", key, value))
+		return
+	}
+
+	fieldPattern := regexp.MustCompile(`^[0-9A-Za-z*?/,#\-]+$`)
+	for _, field := range fields {
+		if !fieldPattern.MatchString(field) {
+			errors = append(errors, fmt.Errorf("%q (%q) has an invalid field %q", key, value, field))
+		}
+	}
+
+	if (fields[3] == "?") == (fields[5] == "?") {
+		errors = append(errors, fmt.Errorf("%q (%q) must use '?' for exactly one of day of month or day of week", key, value))
+	}
+
+	return
+}
+
+// defaultResourceTimeout is how long a resource operation may take before its requests to GoCD are cancelled, unless
+// it is changed in the `timeouts` block of the resource.
+const defaultResourceTimeout = 5 * time.Minute
+
+// resourceTimeouts returns the operation timeouts of a resource which can be created, updated and deleted.
+func resourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultResourceTimeout),
+		Read:   schema.DefaultTimeout(defaultResourceTimeout),
+		Update: schema.DefaultTimeout(defaultResourceTimeout),
+		Delete: schema.DefaultTimeout(defaultResourceTimeout),
+	}
+}
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"regexp"
 	"testing"
 )
@@ -16,6 +21,32 @@ func TestConfigHelper(t *testing.T) {
 	t.Run("RegexRuleSetValidator", testRegexRuleSetValidator)
 	t.Run("SupressJsonDiff", testSupressJSONDiffs)
 	t.Run("SupressJsonDiffPanic", testSupressJSONDiffsPanic)
+	t.Run("ValidateCronSpec", testValidateCronSpec)
+	t.Run("ConflictDiagnostics", testConflictDiagnostics)
+	t.Run("StateVersion", testStateVersion)
+}
+
+func testValidateCronSpec(t *testing.T) {
+	for _, spec := range []string{
+		"0 0 22 ? * MON-FRI",
+		"0 15 10 ? * 6L 2030",
+		"0 0/5 14,18 * * ?",
+	} {
+		_, errs := validateCronSpec(spec, "spec")
+		assert.Empty(t, errs, spec)
+	}
+
+	for spec, expectedError := range map[string]string{
+		"0 22 * * *":         `"spec" \("0 22 \* \* \*"\) must have 6 or 7 fields, starting with seconds`,
+		"0 0 22 * * MON-FRI": `must use '\?' for exactly one of day of month or day of week`,
+		"0 0 22 ? * ?":       `must use '\?' for exactly one of day of month or day of week`,
+		"0 0 22 ? * MON;FRI": `has an invalid field "MON;FRI"`,
+	} {
+		_, errs := validateCronSpec(spec, "spec")
+		if assert.Len(t, errs, 1, spec) {
+			assert.Regexp(t, expectedError, errs[0].Error())
+		}
+	}
 }
 
 func testRegexRuleSetValidator(t *testing.T) {
@@ -201,3 +232,87 @@ func testSupressJSONDiffs(t *testing.T) {
 		})
 	}
 }
+
+func testConflictDiagnostics(t *testing.T) {
+	assert.Nil(t, conflictDiagnostics("role", "admins", []gocd.Conflict{}, nil))
+
+	diags := conflictDiagnostics("role", "admins", []gocd.Conflict{
+		{Version: "v2", Fields: []string{"attributes"}},
+	}, nil)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "role 'admins' was changed concurrently", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "version 'v2'")
+	assert.Contains(t, diags[0].Detail, "`attributes`")
+
+	assert.Nil(t, conflictDiagnostics("role", "admins", []gocd.Conflict{
+		{Version: "v2", Fields: []string{}},
+	}, nil))
+
+	conflicts := []gocd.Conflict{
+		{Version: "v2", Fields: []string{"attributes"}},
+		{Version: "v3", Fields: []string{"attributes", "type"}},
+	}
+	diags = conflictDiagnostics("role", "admins", conflicts, &gocd.ConflictError{
+		Attempts:  3,
+		Conflicts: conflicts,
+		Err:       errors.New("Received HTTP Status '412 Precondition Failed'"),
+	})
+	assert.Len(t, diags, 3)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "role 'admins' kept being changed concurrently", diags[2].Summary)
+	assert.Contains(t, diags[2].Detail, "Concurrently changed fields: `attributes`, `type`.")
+
+	diags = conflictDiagnostics("role", "admins", []gocd.Conflict{}, errors.New("boom"))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "boom", diags[0].Summary)
+}
+
+// testStateVersion resolves a conflict on a config repository, whose configuration is changed by the update while its
+// material is changed concurrently. Only the material is reported.
+func testStateVersion(t *testing.T) {
+	configRepo := func(version, url string) *gocd.ConfigRepo {
+		return &gocd.ConfigRepo{
+			ID:       "repo1",
+			PluginID: "json.config.plugin",
+			Material: gocd.Material{
+				Type:       "git",
+				Attributes: &gocd.MaterialAttributesGit{URL: url, Branch: "master", AutoUpdate: true},
+			},
+			Configuration: []*gocd.ConfigRepoProperty{{Key: "pipeline_pattern", Value: "*.gocd.json"}},
+			Version:       version,
+		}
+	}
+
+	rd := resourceConfigRepo().Data(nil)
+	assert.NoError(t, readConfigRepo(rd, configRepo("v1", "https://github.com/gocd/gocd"), nil))
+	d := resourceConfigRepo().Data(rd.State())
+	assert.NoError(t, d.Set("configuration", []interface{}{
+		map[string]interface{}{"key": "pipeline_pattern", "value": "*.json"},
+	}))
+
+	base, err := stateVersion(resourceConfigRepo(), d, nil, readConfigRepoVersion, extractConfigRepoVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", base.GetVersion())
+
+	desired, err := extractConfigRepo(d)
+	assert.NoError(t, err)
+	desired.Version = "v1"
+
+	client := gocd.NewClient(&gocd.Configuration{MaxConflictRetries: 1}, nil)
+	conflicts, err := client.UpdateWithConflictResolution(context.Background(), base, desired,
+		func(ctx context.Context) (gocd.Versioned, error) {
+			current := configRepo("v2", "https://github.com/gocd/gocd-mirror")
+			return stateVersion(resourceConfigRepo(), d, current, readConfigRepoVersion, extractConfigRepoVersion)
+		},
+		func(ctx context.Context) error {
+			if desired.Version == "v1" {
+				return &gocd.APIError{StatusCode: http.StatusPreconditionFailed}
+			}
+			return nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gocd.Conflict{{Version: "v2", Fields: []string{"material"}}}, conflicts)
+	assert.Equal(t, "*.json", desired.Configuration[0].Value)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// conflictDiagnostics reports the result of gocd.Client.UpdateWithConflictResolution. Each concurrent change which
+// was overwritten is a warning, so that it is not lost silently, and conflicts which could not be resolved are an
+// error listing the fields which kept changing.
+func conflictDiagnostics(kind, name string, conflicts []gocd.Conflict, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, c := range conflicts {
+		// Nothing managed by the resource was overwritten, so there is nothing to warn about.
+		if len(c.Fields) == 0 {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s '%s' was changed concurrently", kind, name),
+			Detail: fmt.Sprintf("The %s was saved by someone else while it was being updated. The configuration was "+
+				"applied again over version '%s', replacing the concurrent changes to: %s.",
+				kind, c.Version, conflictFields(c.Fields)),
+		})
+	}
+
+	var conflictErr *gocd.ConflictError
+	if errors.As(err, &conflictErr) {
+		fields := []string{}
+		for _, c := range conflictErr.Conflicts {
+			for _, f := range c.Fields {
+				if !stringInSlice(f, fields) {
+					fields = append(fields, f)
+				}
+			}
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s '%s' kept being changed concurrently", kind, name),
+			Detail: fmt.Sprintf("The %s was saved by someone else during each of the %d attempts to update it. "+
+				"Concurrently changed fields: %s. Last error: %s",
+				kind, conflictErr.Attempts, conflictFields(fields), conflictErr.Err),
+		})
+	}
+
+	return append(diags, diag.FromErr(err)...)
+}
+
+func conflictFields(fields []string) string {
+	if len(fields) == 0 {
+		return "no managed field"
+	}
+	return "`" + strings.Join(fields, "`, `") + "`"
+}
+
+// stateVersion builds an object with extract from the state of r before the update. Without current, it is the object
+// as it was last read from GoCD, to be used as the base of gocd.Client.UpdateWithConflictResolution. With current, it
+// is set in that state with read first, so that the current object is built like the base and only differs from it on
+// the fields changed concurrently, not on the defaults filled in by GoCD.
+func stateVersion(r *schema.Resource, d *schema.ResourceData, current gocd.Versioned,
+	read func(*schema.ResourceData, gocd.Versioned) error,
+	extract func(*schema.ResourceData) (gocd.Versioned, error)) (gocd.Versioned, error) {
+	prior := r.Data(nil)
+	prior.SetId(d.Id())
+	for k := range r.Schema {
+		old, _ := d.GetChange(k)
+		if err := prior.Set(k, old); err != nil {
+			return nil, err
+		}
+	}
+
+	version := prior.Get("version").(string)
+	if current != nil {
+		if err := read(prior, current); err != nil {
+			return nil, err
+		}
+		version = current.GetVersion()
+	}
+
+	v, err := extract(prior)
+	if err != nil {
+		return nil, err
+	}
+	v.SetVersion(version)
+	return v, nil
+}
@@ -1,15 +1,19 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // codebeat:disable[LOC]
 func dataSourceGocdJobTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceGocdJobTemplateRead,
+		ReadContext: dataSourceGocdJobTemplateRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -98,15 +102,48 @@ func dataSourceGocdJobTemplate() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"build",
+								"test",
+								"external",
+							}, false),
 						},
 						"source": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path of the `build` or `test` artifact on the agent.",
 						},
 						"destination": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"artifact_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Identifier of an `external` artifact, used by fetch tasks.",
+						},
+						"store_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Artifact store an `external` artifact is published to.",
+						},
+						"configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+							Description: "Artifact plugin configuration of an `external` artifact.",
+						},
 					},
 				},
 			},
@@ -140,14 +177,14 @@ func dataSourceGocdJobTemplate() *schema.Resource {
 
 // codebeat:enable[LOC]
 
-func dataSourceGocdJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceGocdJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	tasks := []*gocd.Task{}
 	for _, rawTask := range d.Get("tasks").([]interface{}) {
 		task := gocd.Task{}
 		err := json.Unmarshal([]byte(rawTask.(string)), &task)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		tasks = append(tasks, &task)
 	}
@@ -201,16 +238,46 @@ func dataSourceGocdJobTemplateRead(d *schema.ResourceData, meta interface{}) err
 	if resources := d.Get("artifacts").([]interface{}); len(resources) > 0 {
 		j.Artifacts = []*gocd.Artifact{}
 		for _, rawArtifact := range resources {
-			artifactMap := rawArtifact.(map[string]interface{})
-			j.Artifacts = append(j.Artifacts, &gocd.Artifact{
-				Type:        artifactMap["type"].(string),
-				Source:      artifactMap["source"].(string),
-				Destination: artifactMap["destination"].(string),
-			})
+			artifact, err := dataSourceGocdJobArtifactRead(rawArtifact.(map[string]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			j.Artifacts = append(j.Artifacts, artifact)
+		}
+	}
+
+	return diag.FromErr(definitionDocFinish(d, j))
+}
+
+func dataSourceGocdJobArtifactRead(artifactMap map[string]interface{}) (*gocd.Artifact, error) {
+	artifact := &gocd.Artifact{
+		Type:        artifactMap["type"].(string),
+		Source:      artifactMap["source"].(string),
+		Destination: artifactMap["destination"].(string),
+	}
+
+	if artifact.Type != "external" {
+		if artifact.Source == "" {
+			return nil, fmt.Errorf("`source` is required for artifacts of type '%s'", artifact.Type)
 		}
+		return artifact, nil
+	}
+
+	artifact.ID = artifactMap["artifact_id"].(string)
+	artifact.StoreID = artifactMap["store_id"].(string)
+	if artifact.ID == "" || artifact.StoreID == "" {
+		return nil, fmt.Errorf("`artifact_id` and `store_id` are required for artifacts of type 'external'")
+	}
+
+	for _, rawConfiguration := range artifactMap["configuration"].([]interface{}) {
+		configuration := rawConfiguration.(map[string]interface{})
+		artifact.Configuration = append(artifact.Configuration, &gocd.PluginConfigurationProperty{
+			Key:   configuration["key"].(string),
+			Value: configuration["value"].(string),
+		})
 	}
 
-	return definitionDocFinish(d, j)
+	return artifact, nil
 }
 
 func dataSourceGocdJobPropertiesRead(rawProps []interface{}) []*gocd.JobProperty {
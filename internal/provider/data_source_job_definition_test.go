@@ -7,7 +7,7 @@ import (
 )
 
 func testDataSourceJobDefinition(t *testing.T) {
-	for i := 0; i <= 1; i++ {
+	for i := 0; i <= 2; i++ {
 		t.Run(
 			fmt.Sprintf("gocd_job_definition.%d", i),
 			dataSourceJobDefinition(t, i,
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strconv"
@@ -19,7 +21,7 @@ func dataSourceGocdStageDefinition() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourceGocdStageDefinitionRead,
+		ReadContext: dataSourceGocdStageDefinitionRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -128,7 +130,7 @@ func dataSourceGocdStageDefinition() *schema.Resource {
 
 // codebeat:enable[LOC]
 
-func dataSourceGocdStageDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceGocdStageDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	stage := gocd.Stage{
 		Name: d.Get("name").(string),
 		Approval: &gocd.Approval{
@@ -161,7 +163,7 @@ func dataSourceGocdStageDefinitionRead(d *schema.ResourceData, meta interface{})
 	jsonDoc, err := json.MarshalIndent(stage, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	jsonString := string(jsonDoc)
 	d.Set("json", jsonString)
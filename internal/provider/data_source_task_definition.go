@@ -1,11 +1,13 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strconv"
@@ -14,7 +16,7 @@ import (
 // codebeat:disable[LOC]
 func dataSourceGocdTaskDefinition() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceGocdTaskDefinitionRead,
+		ReadContext: dataSourceGocdTaskDefinitionRead,
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:     schema.TypeString,
@@ -112,7 +114,7 @@ func dataSourceGocdTaskDefinition() *schema.Resource {
 
 // codebeat:enable[LOC]
 
-func dataSourceGocdTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceGocdTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	task := gocd.Task{
 		Type:       d.Get("type").(string),
@@ -139,13 +141,13 @@ func dataSourceGocdTaskDefinitionRead(d *schema.ResourceData, meta interface{})
 	case "pluggable":
 		dataSourceGocdPluggabeTemplate(&task, d)
 	default:
-		return fmt.Errorf("unexpected `gocd.Task.Type`: '%s'", task.Type)
+		return diag.FromErr(fmt.Errorf("unexpected `gocd.Task.Type`: '%s'", task.Type))
 	}
 
 	jsonDoc, err := json.MarshalIndent(task, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return diag.FromErr(err)
 	}
 	jsonString := string(jsonDoc)
 	d.Set("json", jsonString)
@@ -20,7 +20,7 @@ func testResourcePipelineTemplateImportBasic(t *testing.T) {
 		CheckDestroy: testGocdPipelineTemplateDestroy,
 		Steps: []r.TestStep{
 			{
-				Config: testGocdPipelineTemplateConfig(suffix),
+				Config: testGocdPipelineTemplateConfig(suffix, 0),
 			},
 			{
 				ResourceName:      resourceName,
@@ -31,6 +31,30 @@ func testResourcePipelineTemplateImportBasic(t *testing.T) {
 	})
 }
 
+// testResourcePipelineTemplateImportStageBlocks imports a template configured with `stage` blocks. The import sets
+// `stages`, which the next apply replaces with the `stage` blocks, so both are ignored by the verification.
+func testResourcePipelineTemplateImportStageBlocks(t *testing.T) {
+	suffix := randomString(10)
+	resourceName := fmt.Sprintf("gocd_pipeline_template.test-%s", suffix)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineTemplateDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testGocdPipelineTemplateConfig(suffix, 1),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stage", "stages"},
+			},
+		},
+	})
+}
+
 func testGocdPipelineTemplateDestroy(s *terraform.State) error {
 
 	gocdclient := testGocdProvider.Meta().(*gocd.Client)
@@ -50,9 +74,9 @@ func testGocdPipelineTemplateDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testGocdPipelineTemplateConfig(suffix string) string {
+func testGocdPipelineTemplateConfig(suffix string, idx int) string {
 	return strings.Replace(
-		testFile("resource_pipeline_template.0.rsc.tf"),
+		testFile(fmt.Sprintf("resource_pipeline_template.%d.rsc.tf", idx)),
 		"test-pipeline",
 		"test-"+suffix,
 		-1,
@@ -36,6 +36,34 @@ func testResourcePipelineImportBasic(t *testing.T) {
 	}
 }
 
+// testResourcePipelineImportStageBlocks imports pipelines configured with `stage` blocks. The import sets `stages`,
+// which the next apply replaces with the `stage` blocks, so both are ignored by the verification.
+func testResourcePipelineImportStageBlocks(t *testing.T) {
+	for _, idx := range []int{6, 8} {
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			suffix := randomString(10)
+			resourceName := fmt.Sprintf("gocd_pipeline.test-%s", suffix)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:     func() { testAccPreCheck(t) },
+				Providers:    testGocdProviders,
+				CheckDestroy: testGocdPipelineDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: testGocdPipelineConfig(suffix, idx),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"stage", "stages"},
+					},
+				},
+			})
+		})
+	}
+}
+
 func testGocdPipelineDestroy(s *terraform.State) error {
 
 	gocdclient := testGocdProvider.Meta().(*gocd.Client)
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const pluginExtensionElasticAgent = "elastic-agent"
+
+// pluginPropertiesSchema describes the key/value configuration properties passed to a plugin.
+func pluginPropertiesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"encrypted_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+		Description: description,
+	}
+}
+
+// extractPluginProperties converts the properties from the resource schema into plugin configuration properties.
+func extractPluginProperties(rawProperties []interface{}) []*gocd.PluginConfigurationProperty {
+	properties := []*gocd.PluginConfigurationProperty{}
+	for _, rawProperty := range rawProperties {
+		property := rawProperty.(map[string]interface{})
+		p := &gocd.PluginConfigurationProperty{
+			Key:   property["key"].(string),
+			Value: property["value"].(string),
+		}
+		// A plain text value takes precedence over an encrypted value remaining from a previous read.
+		if p.Value == "" {
+			p.EncryptedValue = property["encrypted_value"].(string)
+		}
+		properties = append(properties, p)
+	}
+	return properties
+}
+
+// flattenPluginProperties converts plugin configuration properties from the GoCD API into the resource schema.
+// Secure properties are only ever returned encrypted, so the plain text value from the configuration is kept.
+func flattenPluginProperties(d *schema.ResourceData, key string, properties []*gocd.PluginConfigurationProperty) []interface{} {
+	plaintext := map[string]string{}
+	for _, rawProperty := range d.Get(key).([]interface{}) {
+		property := rawProperty.(map[string]interface{})
+		plaintext[property["key"].(string)] = property["value"].(string)
+	}
+
+	flattened := []interface{}{}
+	for _, property := range properties {
+		value := property.Value
+		if property.EncryptedValue != "" {
+			value = plaintext[property.Key]
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"key":             property.Key,
+			"value":           value,
+			"encrypted_value": property.EncryptedValue,
+		})
+	}
+	return flattened
+}
+
+// getPluginInfo retrieves the metadata advertised by a plugin. A nil plugin is returned if the plugin is not installed
+// on the server.
+func getPluginInfo(ctx context.Context, client *gocd.Client, pluginID string) (*gocd.Plugin, error) {
+	plugin, _, err := client.Plugins.Get(ctx, pluginID)
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plugin, nil
+}
+
+// validatePluginProperties checks the properties against the settings advertised by an elastic agent plugin. Plugins
+// which are not installed on the server are not validated, as the server will reject the configuration anyway.
+func validatePluginProperties(ctx context.Context, client *gocd.Client, pluginID string, properties []*gocd.PluginConfigurationProperty, settings func(*gocd.PluginExtension) gocd.ExtensionSettings) error {
+	plugin, err := getPluginInfo(ctx, client, pluginID)
+	if err != nil || plugin == nil {
+		return err
+	}
+
+	extension := plugin.GetExtension(pluginExtensionElasticAgent)
+	if extension == nil {
+		return fmt.Errorf("plugin '%s' is not an elastic agent plugin", pluginID)
+	}
+
+	if err = settings(extension).ValidateProperties(properties); err != nil {
+		return fmt.Errorf("plugin '%s': %s", pluginID, err)
+	}
+	return nil
+}
+
+// encryptSecurePluginProperties replaces the plain text value of every property which the settings mark as secure with
+// a value encrypted by the GoCD server, so that secrets are never sent to or stored by GoCD in plain text.
+func encryptSecurePluginProperties(ctx context.Context, client *gocd.Client, settings gocd.ExtensionSettings, properties []*gocd.PluginConfigurationProperty) error {
+	for _, property := range properties {
+		configuration := settings.GetConfiguration(property.Key)
+		if configuration == nil || !configuration.Metadata.Secure || property.Value == "" {
+			continue
+		}
+
+		cipher, _, err := client.Encryption.Encrypt(ctx, property.Value)
+		if err != nil {
+			return err
+		}
+		property.Value = ""
+		property.EncryptedValue = cipher.EncryptedValue
+	}
+	return nil
+}
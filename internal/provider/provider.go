@@ -2,15 +2,26 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"net/http"
 	"os"
-	"strings"
+	"strconv"
+	"time"
+)
+
+// defaultMaxConcurrentRequests is the number of requests the provider sends to GoCD at the same time, which matches the
+// default parallelism of Terraform.
+const defaultMaxConcurrentRequests = 10
+
+// Defaults of the retries of requests which failed with a transient error.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMaxWait = 30
 )
 
 var descriptions map[string]string
@@ -33,6 +44,29 @@ func init() {
 		"baseurl":  "URL for the GoCD Server",
 		"username": "User to interact with the GoCD API with.",
 		"password": "Password for User for GoCD API interaction.",
+		"token": "Personal access token to interact with the GoCD API with, instead of `username` and `password`. " +
+			"Defaults to `GOCD_TOKEN` if it is set.",
+		"max_concurrent_requests": "Maximum number of requests sent to the GoCD Server at the same time. " +
+			"`0` removes the limit. Defaults to `" + strconv.Itoa(defaultMaxConcurrentRequests) + "`, or `GOCD_MAX_CONCURRENT_REQUESTS` if it is set.",
+		"max_retries": "Number of times a request is sent again after a transient failure, such as a conflicting config " +
+			"save or the server restarting. `0` disables retries. Defaults to `" + strconv.Itoa(defaultMaxRetries) + "`, or `GOCD_MAX_RETRIES` if it is set.",
+		"retry_max_wait": "Maximum number of seconds to wait between two attempts of a request. Defaults to `" +
+			strconv.Itoa(defaultRetryMaxWait) + "`, or `GOCD_RETRY_MAX_WAIT` if it is set.",
+		"max_conflict_retries": "Number of times the update of a pipeline, pipeline template, role or config repository " +
+			"is applied again over the current version, when the object was saved by someone else since it was read. " +
+			"Every overwritten change is reported as a warning. Defaults to `0`, which fails the update instead, " +
+			"or `GOCD_MAX_CONFLICT_RETRIES` if it is set.",
+		"ca_cert_file": "Path to a PEM encoded bundle of CA certificates trusted for the GoCD Server, in addition to the " +
+			"system certificates. Defaults to `GOCD_CA_CERT_FILE` if it is set.",
+		"ca_cert_pem": "PEM encoded CA certificates trusted for the GoCD Server, in addition to the system certificates. " +
+			"Defaults to `GOCD_CA_CERT_PEM` if it is set.",
+		"client_cert": "PEM encoded client certificate presented to the GoCD Server for mutual TLS. Requires `client_key`. " +
+			"Defaults to `GOCD_CLIENT_CERT` if it is set.",
+		"client_key": "PEM encoded private key of `client_cert`. Defaults to `GOCD_CLIENT_KEY` if it is set.",
+		"proxy_url": "URL of the proxy the requests to the GoCD Server are sent through. Defaults to `GOCD_PROXY_URL` if it " +
+			"is set, otherwise the proxy is read from `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY`.",
+		"request_timeout": "Maximum number of seconds a single request to the GoCD Server may take, including reading the " +
+			"response. Defaults to `0`, which does not limit requests, or `GOCD_REQUEST_TIMEOUT` if it is set.",
 	}
 }
 
@@ -45,10 +79,27 @@ func New(version string) func() *schema.Provider {
 				"gocd_task_definition":  dataSourceGocdTaskDefinition(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
+				"gocd_access_token":            resourceAccessToken(),
+				"gocd_agent":                   resourceAgent(),
+				"gocd_artifact_store":          resourceArtifactStore(),
+				"gocd_auth_config":             resourceAuthConfig(),
+				"gocd_cluster_profile":         resourceClusterProfile(),
+				"gocd_config_repo":             resourceConfigRepo(),
+				"gocd_elastic_agent_profile":   resourceElasticAgentProfile(),
 				"gocd_environment":             resourceEnvironment(),
 				"gocd_environment_association": resourceEnvironmentAssociation(),
+				"gocd_package":                 resourcePackage(),
+				"gocd_package_repository":      resourcePackageRepository(),
 				"gocd_pipeline_template":       resourcePipelineTemplate(),
 				"gocd_pipeline":                resourcePipeline(),
+				"gocd_pipeline_group":          resourcePipelineGroup(),
+				"gocd_plugin_settings":         resourcePluginSettings(),
+				"gocd_role":                    resourceRole(),
+				"gocd_scm":                     resourceSCM(),
+				"gocd_secret_config":           resourceSecretConfig(),
+				"gocd_server_configuration":    resourceServerConfiguration(),
+				"gocd_system_admins":           resourceSystemAdmins(),
+				"gocd_user":                    resourceUser(),
 			},
 			Schema: map[string]*schema.Schema{
 				"baseurl": {
@@ -69,12 +120,90 @@ func New(version string) func() *schema.Provider {
 					Description: descriptions["password"],
 					DefaultFunc: envDefault("GOCD_PASSWORD"),
 				},
+				"token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: descriptions["token"],
+					DefaultFunc: envDefault("GOCD_TOKEN"),
+				},
 				"skip_ssl_check": {
 					Type:        schema.TypeBool,
 					Optional:    true,
 					Description: descriptions["skip_ssl_check"],
 					DefaultFunc: envDefault("GOCD_SKIP_SSL_CHECK"),
 				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["max_concurrent_requests"],
+					DefaultFunc:  schema.EnvDefaultFunc("GOCD_MAX_CONCURRENT_REQUESTS", defaultMaxConcurrentRequests),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["max_retries"],
+					DefaultFunc:  schema.EnvDefaultFunc("GOCD_MAX_RETRIES", defaultMaxRetries),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_max_wait": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["retry_max_wait"],
+					DefaultFunc:  schema.EnvDefaultFunc("GOCD_RETRY_MAX_WAIT", defaultRetryMaxWait),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_conflict_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["max_conflict_retries"],
+					DefaultFunc:  schema.EnvDefaultFunc("GOCD_MAX_CONFLICT_RETRIES", 0),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"ca_cert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   descriptions["ca_cert_file"],
+					DefaultFunc:   envDefault("GOCD_CA_CERT_FILE"),
+					ConflictsWith: []string{"ca_cert_pem"},
+				},
+				"ca_cert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   descriptions["ca_cert_pem"],
+					DefaultFunc:   envDefault("GOCD_CA_CERT_PEM"),
+					ConflictsWith: []string{"ca_cert_file"},
+				},
+				"client_cert": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["client_cert"],
+					DefaultFunc:  envDefault("GOCD_CLIENT_CERT"),
+					RequiredWith: []string{"client_key"},
+				},
+				"client_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  descriptions["client_key"],
+					DefaultFunc:  envDefault("GOCD_CLIENT_KEY"),
+					RequiredWith: []string{"client_cert"},
+				},
+				"proxy_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["proxy_url"],
+					DefaultFunc:  envDefault("GOCD_PROXY_URL"),
+					ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				},
+				"request_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["request_timeout"],
+					DefaultFunc:  schema.EnvDefaultFunc("GOCD_REQUEST_TIMEOUT", 0),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 			},
 		}
 
@@ -114,6 +243,9 @@ func configure(_ string, _ *schema.Provider) func(context.Context, *schema.Resou
 		}
 		log.Printf("[DEBUG] Using GoCD config 'password': %s", rP)
 
+		token := d.Get("token").(string)
+		log.Printf("[DEBUG] Using GoCD config 'token': %t", token != "")
+
 		if rB, ok = d.GetOk("skip_ssl_check"); ok {
 			if b, ok = rB.(bool); !ok {
 				nossl = false
@@ -123,26 +255,57 @@ func configure(_ string, _ *schema.Provider) func(context.Context, *schema.Resou
 		}
 		log.Printf("[DEBUG] Using GoCD config 'skip_ssl_check': %t", nossl)
 
+		maxRequests := d.Get("max_concurrent_requests").(int)
+		log.Printf("[DEBUG] Using GoCD config 'max_concurrent_requests': %d", maxRequests)
+
+		maxRetries := d.Get("max_retries").(int)
+		retryMaxWait := time.Duration(d.Get("retry_max_wait").(int)) * time.Second
+		log.Printf("[DEBUG] Using GoCD config 'max_retries': %d, 'retry_max_wait': %s", maxRetries, retryMaxWait)
+
+		maxConflictRetries := d.Get("max_conflict_retries").(int)
+		log.Printf("[DEBUG] Using GoCD config 'max_conflict_retries': %d", maxConflictRetries)
+
+		caCertFile := d.Get("ca_cert_file").(string)
+		caCertPEM := d.Get("ca_cert_pem").(string)
+		log.Printf("[DEBUG] Using GoCD config 'ca_cert_file': %s, 'ca_cert_pem': %t", caCertFile, caCertPEM != "")
+
+		clientCert := d.Get("client_cert").(string)
+		log.Printf("[DEBUG] Using GoCD config 'client_cert': %t", clientCert != "")
+
+		proxyURL := d.Get("proxy_url").(string)
+		log.Printf("[DEBUG] Using GoCD config 'proxy_url': %s", proxyURL)
+
+		requestTimeout := time.Duration(d.Get("request_timeout").(int)) * time.Second
+		log.Printf("[DEBUG] Using GoCD config 'request_timeout': %s", requestTimeout)
+
 		cfg = &gocd.Configuration{
-			Server:       url,
-			Username:     u,
-			Password:     p,
-			SkipSslCheck: nossl,
+			Server:                url,
+			Username:              u,
+			Password:              p,
+			Token:                 token,
+			SkipSslCheck:          nossl,
+			MaxConcurrentRequests: maxRequests,
+			MaxRetries:            maxRetries,
+			RetryMaxWait:          retryMaxWait,
+			MaxConflictRetries:    maxConflictRetries,
+			CACertFile:            caCertFile,
+			CACertPEM:             caCertPEM,
+			ClientCert:            clientCert,
+			ClientKey:             d.Get("client_key").(string),
+			ProxyURL:              proxyURL,
+			Timeout:               requestTimeout,
 		}
 
-		hClient := &http.Client{}
-
-		if strings.HasPrefix(cfg.Server, "https") {
-			log.Printf("[DEBUG] GoCD is using https.")
-			hClient.Transport = &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.SkipSslCheck},
-			}
-		} else {
-			hClient.Transport = http.DefaultTransport
+		transport, err := cfg.HTTPTransport()
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
 
 		// Add API logging
-		hClient.Transport = logging.NewTransport("GoCD", hClient.Transport)
+		hClient := &http.Client{
+			Transport: logging.NewTransport("GoCD", transport),
+			Timeout:   cfg.Timeout,
+		}
 		gc := gocd.NewClient(cfg, hClient)
 
 		// No-longer supported by go-gocd
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -49,6 +50,48 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderContextFunctions(t *testing.T) {
+	p := New("dev")()
+	for name, r := range p.ResourcesMap {
+		if r.ReadContext == nil || r.CreateContext == nil || r.DeleteContext == nil {
+			t.Errorf("%s: expected context-aware CRUD functions", name)
+		}
+		if r.Timeouts == nil || r.Timeouts.Read == nil {
+			t.Errorf("%s: expected configurable timeouts", name)
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if r.ReadContext == nil {
+			t.Errorf("%s: expected a context-aware read function", name)
+		}
+	}
+}
+
+func TestProviderConfigureTLS(t *testing.T) {
+	p := New("dev")()
+
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"baseurl":         "https://gocd.local/go",
+		"proxy_url":       "http://proxy.local:3128",
+		"request_timeout": 60,
+	})
+	gc, diags := p.ConfigureContextFunc(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if gc == nil {
+		t.Fatal("expected a client")
+	}
+
+	d = schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"baseurl":     "https://gocd.local/go",
+		"ca_cert_pem": "not a certificate",
+	})
+	if _, diags = p.ConfigureContextFunc(context.Background(), d); !diags.HasError() {
+		t.Error("expected an invalid ca_cert_pem to fail")
+	}
+}
+
 func testStepComparisonCheck(t *TestStepJSONComparison) []resource.TestStep {
 	return []resource.TestStep{
 		{
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+const defaultAccessTokenRevokeCause = "Revoked by Terraform"
+
+// resourceAccessToken manages a personal access token of the user the provider is authenticated as, such as a bot
+// account. GoCD does not delete access tokens, so the access token is revoked when the resource is destroyed.
+func resourceAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessTokenCreate,
+		ReadContext:   resourceAccessTokenRead,
+		UpdateContext: resourceAccessTokenUpdate,
+		DeleteContext: resourceAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessTokenImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "What the access token is used for.",
+			},
+			"revoke_cause": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultAccessTokenRevokeCause,
+				Description: "Reason recorded by GoCD when the access token is revoked on destroy.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token. GoCD only returns it when the access token is created, so it is empty after an import.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User the access token authenticates as.",
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	token, _, err := client.AccessTokens.Create(ctx, d.Get("description").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("token", token.Token)
+	return diag.FromErr(readAccessToken(d, token))
+}
+
+func resourceAccessTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid access token id '%s': %s", d.Id(), err))
+	}
+
+	client := meta.(*gocd.Client)
+
+	token, _, err := client.AccessTokens.Get(ctx, id)
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// A revoked access token can not be used again, so it is replaced.
+	if token.Revoked {
+		d.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(readAccessToken(d, token))
+}
+
+// resourceAccessTokenUpdate only records the new revoke cause, which is used when the access token is destroyed.
+func resourceAccessTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceAccessTokenRead(ctx, d, meta)
+}
+
+func resourceAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid access token id '%s': %s", d.Id(), err))
+	}
+
+	client := meta.(*gocd.Client)
+
+	if _, _, err = client.AccessTokens.Revoke(ctx, id, d.Get("revoke_cause").(string)); err != nil && !gocd.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceAccessTokenImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("revoke_cause", defaultAccessTokenRevokeCause)
+	return []*schema.ResourceData{d}, nil
+}
+
+func readAccessToken(d *schema.ResourceData, token *gocd.AccessToken) error {
+	d.SetId(strconv.Itoa(token.ID))
+	d.Set("description", token.Description)
+	d.Set("username", token.Username)
+	d.Set("created_at", token.CreatedAt)
+	return d.Set("last_used_at", token.LastUsedAt)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strconv"
+	"testing"
+)
+
+func testAccessToken(t *testing.T) {
+	t.Run("Basic", testResourceAccessTokenBasic)
+	t.Run("Import", testResourceAccessTokenImportBasic)
+}
+
+func testResourceAccessTokenBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdAccessTokenDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_access_token.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttrSet("gocd_access_token.test-token", "id"),
+					r.TestCheckResourceAttrSet("gocd_access_token.test-token", "token"),
+					r.TestCheckResourceAttrSet("gocd_access_token.test-token", "username"),
+					r.TestCheckResourceAttr("gocd_access_token.test-token", "revoke_cause", defaultAccessTokenRevokeCause),
+				),
+			},
+			{
+				Config: testFile("resource_access_token.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttrSet("gocd_access_token.test-token", "token"),
+					r.TestCheckResourceAttr("gocd_access_token.test-token", "revoke_cause", "Rotated by the acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAccessTokenImportBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdAccessTokenDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_access_token.0.rsc.tf"),
+			},
+			{
+				ResourceName:            "gocd_access_token.test-token",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+// testGocdAccessTokenDestroy checks that the access tokens were revoked, as GoCD does not delete them.
+func testGocdAccessTokenDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_access_token" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if token, _, err := gocdclient.AccessTokens.Get(context.Background(), id); err == nil && !token.Revoked {
+			return fmt.Errorf("still not revoked")
+		}
+	}
+
+	return nil
+}
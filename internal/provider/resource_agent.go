@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	agentConfigStateEnabled  = "Enabled"
+	agentConfigStateDisabled = "Disabled"
+	agentBuildStateBuilding  = "Building"
+)
+
+// codebeat:disable[LOC]
+func resourceAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentCreate,
+		ReadContext:   resourceAgentRead,
+		UpdateContext: resourceAgentUpdate,
+		DeleteContext: resourceAgentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgentImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of an agent which has already registered with the GoCD server.",
+			},
+			"agent_config_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  agentConfigStateEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					agentConfigStateEnabled,
+					agentConfigStateDisabled,
+				}, false),
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"environments": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Environments the agent belongs to. When it is not set, the environments of the agent are " +
+					"left as they are. Can not be used for agents which are listed in the `agents` of a `gocd_environment`, " +
+					"as both would keep undoing the changes of the other.",
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	uuid := d.Get("uuid").(string)
+
+	client := meta.(*gocd.Client)
+
+	// Agents register themselves with the server, so creating the resource
+	// only adopts the existing agent and applies the desired configuration.
+	agent, _, err := client.Agents.Get(ctx, uuid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	update := gocd.AgentBulkUpdate{
+		Uuids:            []string{uuid},
+		AgentConfigState: d.Get("agent_config_state").(string),
+		Operations: &gocd.AgentBulkOperationsUpdate{
+			Resources: agentOperationUpdate(
+				agent.Resources,
+				decodeConfigStringList(d.Get("resources").(*schema.Set).List()),
+			),
+		},
+	}
+	// The environments of the agent are only managed when they are set, as they may be managed by gocd_environment.
+	if environments, hasEnvironments := d.GetOk("environments"); hasEnvironments {
+		update.Operations.Environments = agentOperationUpdate(
+			agent.Environments,
+			decodeConfigStringList(environments.(*schema.Set).List()),
+		)
+	}
+
+	if _, _, err = client.Agents.BulkUpdate(ctx, update); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(uuid)
+	return resourceAgentRead(ctx, d, meta)
+}
+
+func resourceAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+	agent, _, err := client.Agents.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readAgent(d, agent))
+}
+
+func resourceAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	update := gocd.AgentBulkUpdate{
+		Uuids:      []string{d.Id()},
+		Operations: &gocd.AgentBulkOperationsUpdate{},
+	}
+
+	if d.HasChange("agent_config_state") {
+		update.AgentConfigState = d.Get("agent_config_state").(string)
+	}
+
+	if d.HasChange("resources") {
+		o, n := d.GetChange("resources")
+		update.Operations.Resources = agentOperationUpdate(
+			decodeConfigStringList(o.(*schema.Set).List()),
+			decodeConfigStringList(n.(*schema.Set).List()),
+		)
+	}
+
+	if d.HasChange("environments") {
+		o, n := d.GetChange("environments")
+		update.Operations.Environments = agentOperationUpdate(
+			decodeConfigStringList(o.(*schema.Set).List()),
+			decodeConfigStringList(n.(*schema.Set).List()),
+		)
+	}
+
+	if _, _, err := client.Agents.BulkUpdate(ctx, update); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgentRead(ctx, d, meta)
+}
+
+func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	uuid := d.Id()
+
+	client := meta.(*gocd.Client)
+
+	// GoCD refuses to delete agents which are enabled or still building, so
+	// disable the agent first and wait for any running job to finish.
+	if _, _, err := client.Agents.BulkUpdate(ctx, gocd.AgentBulkUpdate{
+		Uuids:            []string{uuid},
+		AgentConfigState: agentConfigStateDisabled,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		agent, _, err := client.Agents.Get(ctx, uuid)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if agent.BuildState == agentBuildStateBuilding {
+			return resource.RetryableError(fmt.Errorf("agent '%s' is still building", uuid))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, _, err = client.Agents.Delete(ctx, uuid)
+	return diag.FromErr(err)
+}
+
+func resourceAgentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("uuid", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func readAgent(d *schema.ResourceData, agent *gocd.Agent) error {
+	d.SetId(agent.UUID)
+	d.Set("uuid", agent.UUID)
+	d.Set("agent_config_state", agent.AgentConfigState)
+	d.Set("hostname", agent.Hostname)
+	d.Set("ip_address", agent.IPAddress)
+	d.Set("operating_system", agent.OperatingSystem)
+	d.Set("agent_state", agent.AgentState)
+	d.Set("build_state", agent.BuildState)
+
+	if err := d.Set("resources", agent.Resources); err != nil {
+		return err
+	}
+	return d.Set("environments", agent.Environments)
+}
+
+// agentOperationUpdate builds the add/remove operation required to move an agent from the current to the desired list
+// of values. Returns nil when there is nothing to change.
+func agentOperationUpdate(current []string, desired []string) *gocd.AgentBulkOperationUpdate {
+	op := &gocd.AgentBulkOperationUpdate{}
+	for _, value := range desired {
+		if !stringInSlice(value, current) {
+			op.Add = append(op.Add, value)
+		}
+	}
+	for _, value := range current {
+		if !stringInSlice(value, desired) {
+			op.Remove = append(op.Remove, value)
+		}
+	}
+
+	if len(op.Add) == 0 && len(op.Remove) == 0 {
+		return nil
+	}
+	return op
+}
+
+func stringInSlice(value string, list []string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
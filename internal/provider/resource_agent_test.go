@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+)
+
+func testAgent(t *testing.T) {
+	t.Run("OperationUpdate", testResourceAgentOperationUpdate)
+	t.Run("Basic", testResourceAgentBasic)
+	t.Run("Import", testResourceAgentImportBasic)
+}
+
+func testResourceAgentOperationUpdate(t *testing.T) {
+	op := agentOperationUpdate([]string{"linux", "java"}, []string{"linux", "terraform"})
+	assert.Equal(t, []string{"terraform"}, op.Add)
+	assert.Equal(t, []string{"java"}, op.Remove)
+
+	assert.Nil(t, agentOperationUpdate([]string{"linux"}, []string{"linux"}))
+}
+
+func testResourceAgentBasic(t *testing.T) {
+	uuid := testGocdAgentUUID(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testGocdAgentConfig("resource_agent.0.rsc.tf", uuid),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_agent.test-agent", "id", uuid),
+					r.TestCheckResourceAttr("gocd_agent.test-agent", "agent_config_state", "Enabled"),
+					r.TestCheckResourceAttr("gocd_agent.test-agent", "resources.#", "2"),
+				),
+			},
+			{
+				Config: testGocdAgentConfig("resource_agent.1.rsc.tf", uuid),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_agent.test-agent", "agent_config_state", "Disabled"),
+					r.TestCheckResourceAttr("gocd_agent.test-agent", "resources.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAgentImportBasic(t *testing.T) {
+	uuid := testGocdAgentUUID(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testGocdAgentConfig("resource_agent.0.rsc.tf", uuid),
+			},
+			{
+				ResourceName:      "gocd_agent.test-agent",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     uuid,
+			},
+		},
+	})
+}
+
+// testGocdAgentUUID finds an agent registered against the test server. Agents can not be created through the API, so
+// the tests are skipped when none are available.
+func testGocdAgentUUID(t *testing.T) string {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	var agents []*gocd.Agent
+	var err error
+	if agents, _, err = testGocdClient.Agents.List(context.Background()); err != nil || len(agents) == 0 {
+		t.Skip("No agents registered with the GoCD server.")
+	}
+	return agents[0].UUID
+}
+
+func testGocdAgentConfig(file string, uuid string) string {
+	return strings.Replace(testFile(file), "AGENT_UUID", uuid, -1)
+}
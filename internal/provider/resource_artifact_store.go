@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceArtifactStore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceArtifactStoreCreate,
+		ReadContext:   resourceArtifactStoreRead,
+		UpdateContext: resourceArtifactStoreUpdate,
+		DeleteContext: resourceArtifactStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArtifactStoreImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"store_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier referenced by the `store_id` of external artifacts.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Artifact plugin which publishes and fetches the artifacts, eg `cd.go.artifact.docker.registry`.",
+			},
+			"properties": pluginPropertiesSchema("Plugin specific configuration of the artifact store, eg the registry URL and credentials."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArtifactStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	store, _, err := client.ArtifactStores.Create(ctx, extractArtifactStore(d))
+	return diag.FromErr(readArtifactStore(d, store, err))
+}
+
+func resourceArtifactStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	store, _, err := client.ArtifactStores.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readArtifactStore(d, store, nil))
+}
+
+func resourceArtifactStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	st := extractArtifactStore(d)
+	st.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	store, _, err := client.ArtifactStores.Update(ctx, d.Id(), st)
+	return diag.FromErr(readArtifactStore(d, store, err))
+}
+
+func resourceArtifactStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.ArtifactStores.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceArtifactStoreImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("store_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractArtifactStore(d *schema.ResourceData) *gocd.ArtifactStore {
+	return &gocd.ArtifactStore{
+		ID:         d.Get("store_id").(string),
+		PluginID:   d.Get("plugin_id").(string),
+		Properties: extractPluginProperties(d.Get("properties").([]interface{})),
+	}
+}
+
+func readArtifactStore(d *schema.ResourceData, store *gocd.ArtifactStore, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(store.ID)
+	d.Set("store_id", store.ID)
+	d.Set("plugin_id", store.PluginID)
+	d.Set("version", store.Version)
+
+	return d.Set("properties", flattenPluginProperties(d, "properties", store.Properties))
+}
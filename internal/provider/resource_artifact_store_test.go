@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testGocdDockerRegistryArtifactPluginID = "cd.go.artifact.docker.registry"
+
+func testArtifactStore(t *testing.T) {
+	t.Run("Basic", testResourceArtifactStoreBasic)
+	t.Run("Import", testResourceArtifactStoreImportBasic)
+}
+
+func testResourceArtifactStoreBasic(t *testing.T) {
+	testGocdArtifactPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdArtifactStoreDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_artifact_store.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_artifact_store.test-store", "id", "test-store"),
+					r.TestCheckResourceAttr("gocd_artifact_store.test-store", "plugin_id", testGocdDockerRegistryArtifactPluginID),
+					r.TestCheckResourceAttr("gocd_artifact_store.test-store", "properties.#", "4"),
+					r.TestCheckResourceAttr("gocd_artifact_store.test-store", "properties.3.value", "secret"),
+					r.TestCheckResourceAttrSet("gocd_artifact_store.test-store", "properties.3.encrypted_value"),
+				),
+			},
+			{
+				Config: testFile("resource_artifact_store.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_artifact_store.test-store", "properties.1.value", "https://registry.example.com/v2/"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceArtifactStoreImportBasic(t *testing.T) {
+	testGocdArtifactPlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdArtifactStoreDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_artifact_store.0.rsc.tf"), "test-store", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_artifact_store.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Secure values are only returned encrypted by GoCD.
+				ImportStateVerifyIgnore: []string{"properties.3.value"},
+			},
+		},
+	})
+}
+
+// testGocdArtifactPlugin skips the test unless the docker registry artifact plugin is installed on the GoCD server.
+func testGocdArtifactPlugin(t *testing.T) {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	if _, _, err := testGocdClient.Plugins.Get(context.Background(), testGocdDockerRegistryArtifactPluginID); err != nil {
+		t.Skipf("Plugin '%s' is not installed on the GoCD server.", testGocdDockerRegistryArtifactPluginID)
+	}
+}
+
+func testGocdArtifactStoreDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_artifact_store" {
+			continue
+		}
+
+		if _, _, err := gocdclient.ArtifactStores.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAuthConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthConfigCreate,
+		ReadContext:   resourceAuthConfigRead,
+		UpdateContext: resourceAuthConfigUpdate,
+		DeleteContext: resourceAuthConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAuthConfigImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"auth_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier referenced by the `auth_config_id` of plugin roles.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Authorization plugin used to log in, eg `cd.go.authentication.ldap` or `cd.go.authentication.passwordfile`.",
+			},
+			"allow_only_known_users_to_login": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only allow users which already exist in GoCD to log in.",
+			},
+			"properties": pluginPropertiesSchema("Plugin specific configuration of the authorization plugin."),
+			"verify_connection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check that the plugin can connect with this configuration before saving it.",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuthConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ac := extractAuthConfig(d)
+
+	client := meta.(*gocd.Client)
+
+	if err := verifyAuthConfig(ctx, d, client, ac); err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, _, err := client.SecurityAuthConfigs.Create(ctx, ac)
+	return diag.FromErr(readAuthConfig(d, config, err))
+}
+
+func resourceAuthConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	config, _, err := client.SecurityAuthConfigs.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readAuthConfig(d, config, nil))
+}
+
+func resourceAuthConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ac := extractAuthConfig(d)
+	ac.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	if err := verifyAuthConfig(ctx, d, client, ac); err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, _, err := client.SecurityAuthConfigs.Update(ctx, d.Id(), ac)
+	return diag.FromErr(readAuthConfig(d, config, err))
+}
+
+func resourceAuthConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.SecurityAuthConfigs.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceAuthConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("auth_config_id", d.Id())
+	d.Set("verify_connection", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// verifyAuthConfig checks the connection of the authorization plugin when `verify_connection` is set, so that a broken
+// configuration which would lock users out of GoCD is never saved.
+func verifyAuthConfig(ctx context.Context, d *schema.ResourceData, client *gocd.Client, ac *gocd.AuthConfig) error {
+	if !d.Get("verify_connection").(bool) {
+		return nil
+	}
+
+	_, _, err := client.SecurityAuthConfigs.VerifyConnection(ctx, ac)
+	return err
+}
+
+func extractAuthConfig(d *schema.ResourceData) *gocd.AuthConfig {
+	return &gocd.AuthConfig{
+		ID:                         d.Get("auth_config_id").(string),
+		PluginID:                   d.Get("plugin_id").(string),
+		AllowOnlyKnownUsersToLogin: d.Get("allow_only_known_users_to_login").(bool),
+		Properties:                 extractPluginProperties(d.Get("properties").([]interface{})),
+	}
+}
+
+func readAuthConfig(d *schema.ResourceData, config *gocd.AuthConfig, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(config.ID)
+	d.Set("auth_config_id", config.ID)
+	d.Set("plugin_id", config.PluginID)
+	d.Set("allow_only_known_users_to_login", config.AllowOnlyKnownUsersToLogin)
+	d.Set("version", config.Version)
+
+	return d.Set("properties", flattenPluginProperties(d, "properties", config.Properties))
+}
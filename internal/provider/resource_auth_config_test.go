@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testGocdPasswordFileEnvVar names a password file on the GoCD server which contains the user the provider logs in
+// with. Creating an authorization configuration enables authentication on the server, so the tests are skipped
+// unless the provider is still able to log in afterwards.
+const testGocdPasswordFileEnvVar = "GOCD_TEST_PASSWORD_FILE"
+
+func testAuthConfig(t *testing.T) {
+	t.Run("Basic", testResourceAuthConfigBasic)
+	t.Run("Import", testResourceAuthConfigImportBasic)
+}
+
+func testResourceAuthConfigBasic(t *testing.T) {
+	passwordFile := testGocdPasswordFile(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdAuthConfigDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testGocdAuthConfig("resource_auth_config.0.rsc.tf", passwordFile),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_auth_config.test-auth", "id", "test-auth"),
+					r.TestCheckResourceAttr("gocd_auth_config.test-auth", "allow_only_known_users_to_login", "false"),
+					r.TestCheckResourceAttr("gocd_auth_config.test-auth", "properties.0.value", passwordFile),
+				),
+			},
+			{
+				Config: testGocdAuthConfig("resource_auth_config.1.rsc.tf", passwordFile),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_auth_config.test-auth", "allow_only_known_users_to_login", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAuthConfigImportBasic(t *testing.T) {
+	passwordFile := testGocdPasswordFile(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdAuthConfigDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testGocdAuthConfig("resource_auth_config.0.rsc.tf", passwordFile), "test-auth", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:            "gocd_auth_config.test-" + suffix,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_connection"},
+			},
+		},
+	})
+}
+
+func testGocdPasswordFile(t *testing.T) string {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	passwordFile := os.Getenv(testGocdPasswordFileEnvVar)
+	if passwordFile == "" {
+		t.Skipf("Authorization configuration tests skipped unless env '%s' set", testGocdPasswordFileEnvVar)
+	}
+	return passwordFile
+}
+
+func testGocdAuthConfig(file string, passwordFile string) string {
+	return strings.Replace(testFile(file), "PASSWORD_FILE", passwordFile, -1)
+}
+
+func testGocdAuthConfigDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_auth_config" {
+			continue
+		}
+
+		if _, _, err := gocdclient.SecurityAuthConfigs.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceClusterProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterProfileCreate,
+		ReadContext:   resourceClusterProfileRead,
+		UpdateContext: resourceClusterProfileUpdate,
+		DeleteContext: resourceClusterProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterProfileImport,
+		},
+		CustomizeDiff: resourceClusterProfileCustomizeDiff,
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Elastic agent plugin which creates agents in this cluster, eg `cd.go.contrib.elastic-agent.docker`.",
+			},
+			"properties": pluginPropertiesSchema("Cluster configuration, validated against the `cluster_profile_settings` of the plugin."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ClusterProfiles.Create(ctx, extractClusterProfile(d))
+	return diag.FromErr(readClusterProfile(d, profile, err))
+}
+
+func resourceClusterProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ClusterProfiles.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readClusterProfile(d, profile, nil))
+}
+
+func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cp := extractClusterProfile(d)
+	cp.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ClusterProfiles.Update(ctx, d.Id(), cp)
+	return diag.FromErr(readClusterProfile(d, profile, err))
+}
+
+func resourceClusterProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.ClusterProfiles.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceClusterProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("profile_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceClusterProfileCustomizeDiff validates the properties against the cluster profile settings of the plugin, so
+// that unknown or missing keys are reported during plan rather than apply.
+func resourceClusterProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("plugin_id") && !d.HasChange("properties") {
+		return nil
+	}
+	if !d.NewValueKnown("plugin_id") || !d.NewValueKnown("properties") {
+		return nil
+	}
+
+	client := meta.(*gocd.Client)
+
+	return validatePluginProperties(
+		ctx,
+		client,
+		d.Get("plugin_id").(string),
+		extractPluginProperties(d.Get("properties").([]interface{})),
+		func(extension *gocd.PluginExtension) gocd.ExtensionSettings {
+			return extension.ClusterProfileSettings
+		},
+	)
+}
+
+func extractClusterProfile(d *schema.ResourceData) *gocd.ClusterProfile {
+	return &gocd.ClusterProfile{
+		ID:         d.Get("profile_id").(string),
+		PluginID:   d.Get("plugin_id").(string),
+		Properties: extractPluginProperties(d.Get("properties").([]interface{})),
+	}
+}
+
+func readClusterProfile(d *schema.ResourceData, profile *gocd.ClusterProfile, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(profile.ID)
+	d.Set("profile_id", profile.ID)
+	d.Set("plugin_id", profile.PluginID)
+	d.Set("version", profile.Version)
+
+	return d.Set("properties", flattenPluginProperties(d, "properties", profile.Properties))
+}
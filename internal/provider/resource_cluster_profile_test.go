@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testGocdDockerElasticAgentPluginID = "cd.go.contrib.elastic-agent.docker"
+
+func testClusterProfile(t *testing.T) {
+	t.Run("Basic", testResourceClusterProfileBasic)
+	t.Run("Import", testResourceClusterProfileImportBasic)
+	t.Run("InvalidProperties", testResourceClusterProfileInvalidProperties)
+}
+
+func testResourceClusterProfileBasic(t *testing.T) {
+	testGocdElasticAgentPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdClusterProfileDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_cluster_profile.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_cluster_profile.test-cluster", "id", "test-cluster"),
+					r.TestCheckResourceAttr("gocd_cluster_profile.test-cluster", "plugin_id", testGocdDockerElasticAgentPluginID),
+					r.TestCheckResourceAttr("gocd_cluster_profile.test-cluster", "properties.#", "4"),
+					r.TestCheckResourceAttr("gocd_cluster_profile.test-cluster", "properties.2.value", "5"),
+				),
+			},
+			{
+				Config: testFile("resource_cluster_profile.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_cluster_profile.test-cluster", "properties.2.value", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceClusterProfileImportBasic(t *testing.T) {
+	testGocdElasticAgentPlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdClusterProfileDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_cluster_profile.0.rsc.tf"), "test-cluster", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_cluster_profile.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceClusterProfileInvalidProperties(t *testing.T) {
+	testGocdElasticAgentPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config:      strings.Replace(testFile("resource_cluster_profile.0.rsc.tf"), "max_docker_containers", "max_containers", -1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unknown key 'max_containers', missing required key 'max_docker_containers'"),
+			},
+		},
+	})
+}
+
+// testGocdElasticAgentPlugin skips the test unless the docker elastic agent plugin is installed on the GoCD server.
+func testGocdElasticAgentPlugin(t *testing.T) {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	if _, _, err := testGocdClient.Plugins.Get(context.Background(), testGocdDockerElasticAgentPluginID); err != nil {
+		t.Skipf("Plugin '%s' is not installed on the GoCD server.", testGocdDockerElasticAgentPluginID)
+	}
+}
+
+func testGocdClusterProfileDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_cluster_profile" {
+			continue
+		}
+
+		if _, _, err := gocdclient.ClusterProfiles.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
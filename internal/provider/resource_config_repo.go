@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// codebeat:disable[LOC]
+func resourceConfigRepo() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConfigRepoCreate,
+		ReadContext:   resourceConfigRepoRead,
+		UpdateContext: resourceConfigRepoUpdate,
+		DeleteContext: resourceConfigRepoDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceConfigRepoImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"repo_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Config repo plugin used to parse the repository, eg `yaml.config.plugin` or `json.config.plugin`.",
+			},
+			"material": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"git",
+								"hg",
+								"svn",
+								"p4",
+								"tfs",
+							}, false),
+						},
+						"attributes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"branch": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: supressMaterialBranchDiff,
+									},
+									"auto_update": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+									"encrypted_password": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"check_externals": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"port": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"use_tickets": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"view": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"domain": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"project_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encrypted_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func resourceConfigRepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cr, err := extractConfigRepo(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*gocd.Client)
+
+	repo, _, err := client.ConfigRepos.Create(ctx, cr)
+	return diag.FromErr(readConfigRepo(d, repo, err))
+}
+
+func resourceConfigRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	repo, _, err := client.ConfigRepos.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readConfigRepo(d, repo, nil))
+}
+
+func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cr, err := extractConfigRepo(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cr.Version = d.Get("version").(string)
+
+	base, err := stateVersion(resourceConfigRepo(), d, nil, readConfigRepoVersion, extractConfigRepoVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*gocd.Client)
+
+	var repo *gocd.ConfigRepo
+	conflicts, err := client.UpdateWithConflictResolution(ctx, base, cr,
+		func(ctx context.Context) (gocd.Versioned, error) {
+			current, _, err := client.ConfigRepos.Get(ctx, d.Id())
+			if err != nil {
+				return nil, err
+			}
+			return stateVersion(resourceConfigRepo(), d, current, readConfigRepoVersion, extractConfigRepoVersion)
+		},
+		func(ctx context.Context) (err error) {
+			repo, _, err = client.ConfigRepos.Update(ctx, d.Id(), cr)
+			return err
+		})
+	diags := conflictDiagnostics("config repository", d.Id(), conflicts, err)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, diag.FromErr(readConfigRepo(d, repo, nil))...)
+}
+
+func resourceConfigRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.ConfigRepos.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceConfigRepoImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("repo_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractConfigRepo(d *schema.ResourceData) (*gocd.ConfigRepo, error) {
+	cr := &gocd.ConfigRepo{
+		ID:       d.Get("repo_id").(string),
+		PluginID: d.Get("plugin_id").(string),
+	}
+
+	rawMaterial := d.Get("material").([]interface{})[0].(map[string]interface{})
+	cr.Material.Type = rawMaterial["type"].(string)
+
+	attributes := map[string]interface{}{}
+	if rawAttributes := rawMaterial["attributes"].([]interface{}); len(rawAttributes) > 0 && rawAttributes[0] != nil {
+		attributes = rawAttributes[0].(map[string]interface{})
+	}
+	// A plain text password takes precedence over an encrypted password remaining from a previous read.
+	if password, ok := attributes["password"].(string); ok && password != "" {
+		attributes["encrypted_password"] = ""
+	}
+	if err := cr.Material.IngestAttributes(attributes); err != nil {
+		return nil, err
+	}
+
+	for _, property := range extractPluginProperties(d.Get("configuration").([]interface{})) {
+		crp := gocd.ConfigRepoProperty(*property)
+		cr.Configuration = append(cr.Configuration, &crp)
+	}
+
+	return cr, nil
+}
+
+func extractConfigRepoVersion(d *schema.ResourceData) (gocd.Versioned, error) {
+	return extractConfigRepo(d)
+}
+
+func readConfigRepoVersion(d *schema.ResourceData, v gocd.Versioned) error {
+	return readConfigRepo(d, v.(*gocd.ConfigRepo), nil)
+}
+
+func readConfigRepo(d *schema.ResourceData, repo *gocd.ConfigRepo, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repo.ID)
+	d.Set("repo_id", repo.ID)
+	d.Set("plugin_id", repo.PluginID)
+	d.Set("version", repo.Version)
+
+	attributes, err := flattenConfigRepoMaterialAttributes(repo.Material.Attributes)
+	if err != nil {
+		return err
+	}
+	// GoCD only ever returns the encrypted form of a password, so keep the plain text password from the configuration.
+	if password, ok := d.GetOk("material.0.attributes.0.password"); ok {
+		attributes["password"] = password
+	}
+
+	if err = d.Set("material", []interface{}{
+		map[string]interface{}{
+			"type":       repo.Material.Type,
+			"attributes": []interface{}{attributes},
+		},
+	}); err != nil {
+		return err
+	}
+
+	return d.Set("configuration", flattenConfigRepoConfiguration(d, repo.Configuration))
+}
+
+func flattenConfigRepoMaterialAttributes(attributes gocd.MaterialAttribute) (map[string]interface{}, error) {
+	switch a := attributes.(type) {
+	case *gocd.MaterialAttributesGit:
+		return map[string]interface{}{
+			"name":        a.Name,
+			"url":         a.URL,
+			"branch":      a.Branch,
+			"auto_update": a.AutoUpdate,
+		}, nil
+	case *gocd.MaterialAttributesHg:
+		return map[string]interface{}{
+			"name":        a.Name,
+			"url":         a.URL,
+			"auto_update": a.AutoUpdate,
+		}, nil
+	case *gocd.MaterialAttributesSvn:
+		return map[string]interface{}{
+			"name":               a.Name,
+			"url":                a.URL,
+			"username":           a.Username,
+			"encrypted_password": a.EncryptedPassword,
+			"check_externals":    a.CheckExternals,
+			"auto_update":        a.AutoUpdate,
+		}, nil
+	case *gocd.MaterialAttributesP4:
+		return map[string]interface{}{
+			"name":               a.Name,
+			"port":               a.Port,
+			"use_tickets":        a.UseTickets,
+			"view":               a.View,
+			"username":           a.Username,
+			"encrypted_password": a.EncryptedPassword,
+			"auto_update":        a.AutoUpdate,
+		}, nil
+	case *gocd.MaterialAttributesTfs:
+		return map[string]interface{}{
+			"name":               a.Name,
+			"url":                a.URL,
+			"domain":             a.Domain,
+			"project_path":       a.ProjectPath,
+			"username":           a.Username,
+			"encrypted_password": a.EncryptedPassword,
+			"auto_update":        a.AutoUpdate,
+		}, nil
+	}
+	return nil, fmt.Errorf("unexpected config repo material attributes: '%T'", attributes)
+}
+
+// flattenConfigRepoConfiguration converts the configuration properties from the GoCD API into the resource schema, like
+// the properties of other plugins.
+func flattenConfigRepoConfiguration(d *schema.ResourceData, configuration []*gocd.ConfigRepoProperty) []interface{} {
+	properties := []*gocd.PluginConfigurationProperty{}
+	for _, crp := range configuration {
+		property := gocd.PluginConfigurationProperty(*crp)
+		properties = append(properties, &property)
+	}
+	return flattenPluginProperties(d, "configuration", properties)
+}
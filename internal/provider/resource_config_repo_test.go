@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testConfigRepo(t *testing.T) {
+	t.Run("Basic", testResourceConfigRepoBasic)
+	t.Run("Import", testResourceConfigRepoImportBasic)
+}
+
+func testResourceConfigRepoBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdConfigRepoDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_config_repo.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_config_repo.test-config-repo", "id", "test-config-repo"),
+					r.TestCheckResourceAttr("gocd_config_repo.test-config-repo", "material.0.type", "git"),
+					r.TestCheckResourceAttr("gocd_config_repo.test-config-repo", "configuration.0.value", "*.gopipeline.json"),
+				),
+			},
+			{
+				Config: testFile("resource_config_repo.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_config_repo.test-config-repo", "configuration.0.value", "*.pipeline.json"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceConfigRepoImportBasic(t *testing.T) {
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdConfigRepoDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_config_repo.0.rsc.tf"), "test-config-repo", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_config_repo.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdConfigRepoDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_config_repo" {
+			continue
+		}
+
+		if _, _, err := gocdclient.ConfigRepos.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
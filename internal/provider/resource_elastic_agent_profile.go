@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceElasticAgentProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceElasticAgentProfileCreate,
+		ReadContext:   resourceElasticAgentProfileRead,
+		UpdateContext: resourceElasticAgentProfileUpdate,
+		DeleteContext: resourceElasticAgentProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceElasticAgentProfileImport,
+		},
+		CustomizeDiff: resourceElasticAgentProfileCustomizeDiff,
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier referenced by the `elastic_profile_id` of jobs.",
+			},
+			"cluster_profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster profile in which agents for this profile are created.",
+			},
+			"properties": pluginPropertiesSchema("Agent configuration, validated against the elastic agent profile settings of the cluster profile's plugin."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceElasticAgentProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ElasticProfiles.Create(ctx, extractElasticAgentProfile(d))
+	return diag.FromErr(readElasticAgentProfile(d, profile, err))
+}
+
+func resourceElasticAgentProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ElasticProfiles.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readElasticAgentProfile(d, profile, nil))
+}
+
+func resourceElasticAgentProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ep := extractElasticAgentProfile(d)
+	ep.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	profile, _, err := client.ElasticProfiles.Update(ctx, d.Id(), ep)
+	return diag.FromErr(readElasticAgentProfile(d, profile, err))
+}
+
+func resourceElasticAgentProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.ElasticProfiles.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceElasticAgentProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("profile_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceElasticAgentProfileCustomizeDiff validates the properties against the profile settings of the plugin used by
+// the cluster profile. Validation is skipped while the cluster profile does not exist yet, as its plugin is unknown.
+func resourceElasticAgentProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("cluster_profile_id") && !d.HasChange("properties") {
+		return nil
+	}
+	if !d.NewValueKnown("cluster_profile_id") || !d.NewValueKnown("properties") {
+		return nil
+	}
+
+	client := meta.(*gocd.Client)
+
+	cluster, _, err := client.ClusterProfiles.Get(ctx, d.Get("cluster_profile_id").(string))
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return validatePluginProperties(
+		ctx,
+		client,
+		cluster.PluginID,
+		extractPluginProperties(d.Get("properties").([]interface{})),
+		func(extension *gocd.PluginExtension) gocd.ExtensionSettings {
+			// Plugins implementing the elastic agent extension before v5 only advertise `profile_settings`.
+			if len(extension.ElasticAgentProfileSettings.Configurations) > 0 {
+				return extension.ElasticAgentProfileSettings
+			}
+			return extension.ProfileSettings
+		},
+	)
+}
+
+func extractElasticAgentProfile(d *schema.ResourceData) *gocd.ElasticProfile {
+	return &gocd.ElasticProfile{
+		ID:               d.Get("profile_id").(string),
+		ClusterProfileID: d.Get("cluster_profile_id").(string),
+		Properties:       extractPluginProperties(d.Get("properties").([]interface{})),
+	}
+}
+
+func readElasticAgentProfile(d *schema.ResourceData, profile *gocd.ElasticProfile, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(profile.ID)
+	d.Set("profile_id", profile.ID)
+	d.Set("cluster_profile_id", profile.ClusterProfileID)
+	d.Set("version", profile.Version)
+
+	return d.Set("properties", flattenPluginProperties(d, "properties", profile.Properties))
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testElasticAgentProfile(t *testing.T) {
+	t.Run("Basic", testResourceElasticAgentProfileBasic)
+	t.Run("Import", testResourceElasticAgentProfileImportBasic)
+}
+
+func testResourceElasticAgentProfileBasic(t *testing.T) {
+	testGocdElasticAgentPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdElasticAgentProfileDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_elastic_agent_profile.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_elastic_agent_profile.test-profile", "id", "test-profile"),
+					r.TestCheckResourceAttr("gocd_elastic_agent_profile.test-profile", "cluster_profile_id", "test-cluster"),
+					r.TestCheckResourceAttr("gocd_elastic_agent_profile.test-profile", "properties.#", "1"),
+				),
+			},
+			{
+				Config: testFile("resource_elastic_agent_profile.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_elastic_agent_profile.test-profile", "properties.#", "2"),
+					r.TestCheckResourceAttr("gocd_elastic_agent_profile.test-profile", "properties.1.key", "MaxMemory"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceElasticAgentProfileImportBasic(t *testing.T) {
+	testGocdElasticAgentPlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdElasticAgentProfileDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_elastic_agent_profile.0.rsc.tf"), "test-profile", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_elastic_agent_profile.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdElasticAgentProfileDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_elastic_agent_profile" {
+			continue
+		}
+
+		if _, _, err := gocdclient.ElasticProfiles.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
@@ -3,24 +3,65 @@ package provider
 import (
 	"context"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// codebeat:disable[LOC]
 func resourceEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEnvironmentCreate,
-		Read:   resourceEnvironmentRead,
-		Delete: resourceEnvironmentDelete,
-		Exists: resourceEnvironmentExists,
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceEnvironmentImport,
+			StateContext: resourceEnvironmentImport,
 		},
+		Timeouts: resourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				ForceNew: true,
 				Required: true,
 			},
+			"agents": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "UUIDs of the agents in the environment. Agents added through the `environments` of `gocd_agent` are " +
+					"left alone when not set. Can not be used for agents whose `environments` are set in a `gocd_agent`, as both " +
+					"would keep undoing the changes of the other.",
+			},
+			"environment_variables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encrypted_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"secure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+				Description: "Environment variables passed to the jobs of every pipeline in the environment. The `value` of `secure` variables is encrypted by GoCD.",
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -29,48 +70,212 @@ func resourceEnvironment() *schema.Resource {
 	}
 }
 
-func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+// codebeat:enable[LOC]
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
-	env, _, err := client.Environments.Create(context.Background(), name)
+	env, _, err := client.Environments.Create(ctx, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(name)
 	d.Set("version", env.Version)
-	return nil
+
+	if err = updateEnvironment(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	name := d.Id()
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gocd.Client)
-	env, _, err := client.Environments.Get(context.Background(), name)
+	env, _, err := client.Environments.Get(ctx, d.Id())
 	if err != nil {
-		return err
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 	d.Set("version", env.Version)
 
-	return nil
+	if err = d.Set("environment_variables", flattenEnvironmentVariables(d.Get("environment_variables").([]interface{}), env.EnvironmentVariables)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	agents, _, err := client.Agents.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uuids := []string{}
+	for _, agent := range agents {
+		if stringInSlice(env.Name, agent.Environments) {
+			uuids = append(uuids, agent.UUID)
+		}
+	}
+	return diag.FromErr(d.Set("agents", uuids))
 }
 
-func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	name := d.Get("name").(string)
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gocd.Client)
-	_, _, err := client.Environments.Delete(context.Background(), name)
-	return err
+
+	if err := updateEnvironment(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	client := meta.(*gocd.Client)
-	env, _, err := client.Environments.Get(context.Background(), name)
-	exists := (env.Name == name) && (err == nil)
-	return exists, err
+	_, _, err := client.Environments.Delete(ctx, name)
+	return diag.FromErr(err)
 }
 
-func resourceEnvironmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
+
+// updateEnvironment applies the changes to the environment variables and agents in place.
+func updateEnvironment(ctx context.Context, d *schema.ResourceData, client *gocd.Client) error {
+	if d.HasChange("environment_variables") {
+		o, n := d.GetChange("environment_variables")
+		remove, add := environmentVariablesPatch(
+			extractEnvironmentVariables(o.([]interface{})),
+			extractEnvironmentVariables(n.([]interface{})),
+		)
+
+		// Changed variables are removed before they are added again, as GoCD rejects variables which already exist.
+		if len(remove) > 0 {
+			if _, _, err := client.Environments.Patch(ctx, d.Id(), &gocd.EnvironmentPatchRequest{
+				EnvironmentVariables: &gocd.EnvironmentVariablesAction{
+					Add:    []*gocd.EnvironmentVariable{},
+					Remove: remove,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if len(add) > 0 {
+			if _, _, err := client.Environments.Patch(ctx, d.Id(), &gocd.EnvironmentPatchRequest{
+				EnvironmentVariables: &gocd.EnvironmentVariablesAction{
+					Add:    add,
+					Remove: []string{},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Agents are moved between environments through the agents API, as the environments API no longer manages agents
+	// since GoCD 19.9.0.
+	if d.HasChange("agents") {
+		o, n := d.GetChange("agents")
+		op := agentOperationUpdate(
+			decodeConfigStringList(o.(*schema.Set).List()),
+			decodeConfigStringList(n.(*schema.Set).List()),
+		)
+		if op == nil {
+			return nil
+		}
+
+		if len(op.Add) > 0 {
+			if _, _, err := client.Agents.BulkUpdate(ctx, gocd.AgentBulkUpdate{
+				Uuids: op.Add,
+				Operations: &gocd.AgentBulkOperationsUpdate{
+					Environments: &gocd.AgentBulkOperationUpdate{Add: []string{d.Id()}},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if len(op.Remove) > 0 {
+			if _, _, err := client.Agents.BulkUpdate(ctx, gocd.AgentBulkUpdate{
+				Uuids: op.Remove,
+				Operations: &gocd.AgentBulkOperationsUpdate{
+					Environments: &gocd.AgentBulkOperationUpdate{Remove: []string{d.Id()}},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// environmentVariablesPatch returns the names of the variables to remove and the variables to add to move from the
+// current to the desired variables. Changed variables are both removed and added.
+func environmentVariablesPatch(current []*gocd.EnvironmentVariable, desired []*gocd.EnvironmentVariable) (remove []string, add []*gocd.EnvironmentVariable) {
+	remove = []string{}
+	add = []*gocd.EnvironmentVariable{}
+
+	existing := map[string]*gocd.EnvironmentVariable{}
+	for _, v := range current {
+		existing[v.Name] = v
+	}
+	wanted := map[string]bool{}
+	for _, v := range desired {
+		wanted[v.Name] = true
+		if old, ok := existing[v.Name]; ok {
+			if *old == *v {
+				continue
+			}
+			remove = append(remove, v.Name)
+		}
+		add = append(add, v)
+	}
+	for _, v := range current {
+		if !wanted[v.Name] {
+			remove = append(remove, v.Name)
+		}
+	}
+	return
+}
+
+// extractEnvironmentVariables converts the environment variables from the resource schema. A plain text value takes
+// precedence over an encrypted value remaining from a previous read.
+func extractEnvironmentVariables(rawEnvVars []interface{}) []*gocd.EnvironmentVariable {
+	envVars := []*gocd.EnvironmentVariable{}
+	for _, rawEnvVar := range rawEnvVars {
+		envVar := rawEnvVar.(map[string]interface{})
+		v := &gocd.EnvironmentVariable{
+			Name:   envVar["name"].(string),
+			Value:  envVar["value"].(string),
+			Secure: envVar["secure"].(bool),
+		}
+		if v.Value == "" {
+			v.EncryptedValue = envVar["encrypted_value"].(string)
+		}
+		envVars = append(envVars, v)
+	}
+	return envVars
+}
+
+// flattenEnvironmentVariables converts environment variables from the GoCD API into the resource schema. Secure
+// variables are only ever returned encrypted, so the plain text value from the current variables is kept.
+func flattenEnvironmentVariables(current []interface{}, envVars []*gocd.EnvironmentVariable) []interface{} {
+	plaintext := map[string]string{}
+	for _, rawEnvVar := range current {
+		envVar := rawEnvVar.(map[string]interface{})
+		plaintext[envVar["name"].(string)] = envVar["value"].(string)
+	}
+
+	flattened := []interface{}{}
+	for _, envVar := range envVars {
+		value := envVar.Value
+		if envVar.EncryptedValue != "" {
+			value = plaintext[envVar.Name]
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"name":            envVar.Name,
+			"value":           value,
+			"encrypted_value": envVar.EncryptedValue,
+			"secure":          envVar.Secure,
+		})
+	}
+	return flattened
+}
@@ -4,18 +4,23 @@ import (
 	"context"
 	"fmt"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
 func resourceEnvironmentAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEnvironmentAssociationCreate,
-		Read:   resourceEnvironmentAssociationRead,
-		Delete: resourceEnvironmentAssociationDelete,
-		Exists: resourceEnvironmentAssociationExists,
+		CreateContext: resourceEnvironmentAssociationCreate,
+		ReadContext:   resourceEnvironmentAssociationRead,
+		DeleteContext: resourceEnvironmentAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceEnvironmentAssociationImport,
+			StateContext: resourceEnvironmentAssociationImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
 		},
 		Schema: map[string]*schema.Schema{
 			"environment": {
@@ -27,47 +32,7 @@ func resourceEnvironmentAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
-				//	Optional:      true,
-				//	ConflictsWith: []string{"agent", "environment_variable"},
 			},
-			// TODO implement this
-			//"agent": {
-			//	Type:          schema.TypeString,
-			//	Optional:      true,
-			//	ConflictsWith: []string{"pipeline", "environment_variable"},
-			//},
-			//"environment_variable": {
-			//	Type:          schema.TypeList,
-			//	Optional:      true,
-			//	ConflictsWith: []string{"agent", "pipeline"},
-			//	Elem: &schema.Resource{
-			//		Schema: map[string]*schema.Schema{
-			//			"name": {
-			//				Type:     schema.TypeString,
-			//				Required: true,
-			//			},
-			//			"value": {
-			//				Type: schema.TypeString,
-			//				// ConflictsWith can only be applied to top level configs.
-			//				// A custom validation will need to be used.
-			//				//ConflictsWith: []string{"encrypted_value"},
-			//				Optional: true,
-			//			},
-			//			"encrypted_value": {
-			//				Type: schema.TypeString,
-			//				// ConflictsWith can only be applied to top level configs.
-			//				// A custom validation will need to be used.
-			//				//ConflictsWith: []string{"value"},
-			//				Optional: true,
-			//			},
-			//			"secure": {
-			//				Type:     schema.TypeBool,
-			//				Default:  false,
-			//				Optional: true,
-			//			},
-			//		},
-			//	},
-			//},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,84 +41,69 @@ func resourceEnvironmentAssociation() *schema.Resource {
 	}
 }
 
-func resourceEnvironmentAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	environment := d.Get("environment").(string)
 	pipeline := d.Get("pipeline").(string)
 
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
-	env, _, err := client.Environments.Patch(context.Background(), environment, &gocd.EnvironmentPatchRequest{
+	env, _, err := client.Environments.Patch(ctx, environment, &gocd.EnvironmentPatchRequest{
 		Pipelines: &gocd.PatchStringAction{
 			Add:    []string{pipeline},
 			Remove: []string{},
 		},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(environmentAssociationId(environment, pipeline, "", ""))
 	d.Set("version", env.Version)
 	return nil
 }
 
-func resourceEnvironmentAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := strings.Split(d.Id(), "/")
 	environment := id[0]
 	//associationType := id[1]
 	value := id[2]
 	client := meta.(*gocd.Client)
-	env, _, err := client.Environments.Get(context.Background(), environment)
+	env, _, err := client.Environments.Get(ctx, environment)
 	if err != nil {
-		return err
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	for _, p := range env.Pipelines {
 		if p.Name == value {
 			d.Set("pipeline", p.Name)
+			d.Set("version", env.Version)
+			return nil
 		}
 	}
-	d.Set("version", env.Version)
 
+	d.SetId("")
 	return nil
 }
 
-func resourceEnvironmentAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceEnvironmentAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := strings.Split(d.Id(), "/")
 	environment := id[0]
 	//associationType := id[1]
 	value := id[2]
 
 	client := meta.(*gocd.Client)
-	_, _, err := client.Environments.Patch(context.Background(), environment, &gocd.EnvironmentPatchRequest{
+	_, _, err := client.Environments.Patch(ctx, environment, &gocd.EnvironmentPatchRequest{
 		Pipelines: &gocd.PatchStringAction{
 			Add:    []string{},
 			Remove: []string{value},
 		},
 	})
-	return err
-}
-
-func resourceEnvironmentAssociationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	id := strings.Split(d.Id(), "/")
-	environment := id[0]
-	//associationType := id[1]
-	value := id[2]
-
-	client := meta.(*gocd.Client)
-	env, _, err := client.Environments.Get(context.Background(), environment)
-	if err != nil {
-		return false, err
-	}
-	for _, p := range env.Pipelines {
-		if p.Name == value {
-			return true, nil
-		}
-	}
-	return false, err
+	return diag.FromErr(err)
 }
 
-func resourceEnvironmentAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceEnvironmentAssociationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	id := strings.Split(d.Id(), "/")
 	environment := id[0]
 	//associationType := id[1]
@@ -8,6 +8,7 @@ import (
 func testEnvironment(t *testing.T) {
 	t.Run("Import", testResourceEnvironmentImportBasic)
 	t.Run("Basic", testResourceEnvironmentBasic)
+	t.Run("Agents", testResourceEnvironmentAgents)
 }
 
 func testResourceEnvironmentBasic(t *testing.T) {
@@ -26,6 +27,53 @@ func testResourceEnvironmentBasic(t *testing.T) {
 					),
 				),
 			},
+			{
+				Config: testFile("resource_environment.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "environment_variables.#", "2"),
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "environment_variables.0.value", "staging"),
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "environment_variables.1.value", "secret"),
+					r.TestCheckResourceAttrSet("gocd_environment.test-environment", "environment_variables.1.encrypted_value"),
+				),
+			},
+			{
+				Config: testFile("resource_environment.2.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "environment_variables.#", "1"),
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "environment_variables.0.value", "rotated-secret"),
+				),
+			},
+		},
+	})
+}
+
+// testResourceEnvironmentAgents adds an agent to an environment while the agent is also managed by gocd_agent, without
+// its environments, and checks that neither resource plans to undo the change of the other.
+func testResourceEnvironmentAgents(t *testing.T) {
+	uuid := testGocdAgentUUID(t)
+	config := testGocdAgentConfig("resource_environment.3.rsc.tf", uuid)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdEnvironmentDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: config,
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_environment.test-environment", "agents.#", "1"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: config,
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckTypeSetElemAttr("gocd_agent.test-agent", "environments.*", "test-environment"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePackageCreate,
+		ReadContext:   resourcePackageRead,
+		UpdateContext: resourcePackageUpdate,
+		DeleteContext: resourcePackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"package_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Identifier referenced by the `ref` of `package` materials. Generated by GoCD when not set.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repo_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Package repository the package is polled from.",
+			},
+			"auto_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"configuration": pluginPropertiesSchema("Plugin specific configuration of the package, eg the package name."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	pkg, _, err := client.Packages.Create(ctx, extractPackage(d))
+	return diag.FromErr(readPackage(d, pkg, err))
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	pkg, _, err := client.Packages.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readPackage(d, pkg, nil))
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p := extractPackage(d)
+	p.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	pkg, _, err := client.Packages.Update(ctx, d.Id(), p)
+	return diag.FromErr(readPackage(d, pkg, err))
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.Packages.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourcePackageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("package_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractPackage(d *schema.ResourceData) *gocd.Package {
+	return &gocd.Package{
+		ID:            d.Get("package_id").(string),
+		Name:          d.Get("name").(string),
+		AutoUpdate:    d.Get("auto_update").(bool),
+		PackageRepo:   &gocd.PackageRepositoryReference{ID: d.Get("repo_id").(string)},
+		Configuration: extractPluginProperties(d.Get("configuration").([]interface{})),
+	}
+}
+
+func readPackage(d *schema.ResourceData, pkg *gocd.Package, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(pkg.ID)
+	d.Set("package_id", pkg.ID)
+	d.Set("name", pkg.Name)
+	d.Set("auto_update", pkg.AutoUpdate)
+	d.Set("version", pkg.Version)
+
+	if pkg.PackageRepo != nil {
+		d.Set("repo_id", pkg.PackageRepo.ID)
+	}
+
+	return d.Set("configuration", flattenPluginProperties(d, "configuration", pkg.Configuration))
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePackageRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePackageRepositoryCreate,
+		ReadContext:   resourcePackageRepositoryRead,
+		UpdateContext: resourcePackageRepositoryUpdate,
+		DeleteContext: resourcePackageRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageRepositoryImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"repo_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Identifier of the package repository. Generated by GoCD when not set.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Package material plugin which polls the repository, eg `deb` or `yum`.",
+			},
+			"plugin_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1",
+			},
+			"configuration": pluginPropertiesSchema("Plugin specific configuration of the repository, eg the repository URL."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePackageRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	repo, _, err := client.PackageRepositories.Create(ctx, extractPackageRepository(d))
+	return diag.FromErr(readPackageRepository(d, repo, err))
+}
+
+func resourcePackageRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	repo, _, err := client.PackageRepositories.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readPackageRepository(d, repo, nil))
+}
+
+func resourcePackageRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pr := extractPackageRepository(d)
+	pr.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	repo, _, err := client.PackageRepositories.Update(ctx, d.Id(), pr)
+	return diag.FromErr(readPackageRepository(d, repo, err))
+}
+
+func resourcePackageRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.PackageRepositories.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourcePackageRepositoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("repo_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractPackageRepository(d *schema.ResourceData) *gocd.PackageRepository {
+	return &gocd.PackageRepository{
+		ID:   d.Get("repo_id").(string),
+		Name: d.Get("name").(string),
+		PluginMetadata: &gocd.PluginMetadata{
+			ID:      d.Get("plugin_id").(string),
+			Version: d.Get("plugin_version").(string),
+		},
+		Configuration: extractPluginProperties(d.Get("configuration").([]interface{})),
+	}
+}
+
+func readPackageRepository(d *schema.ResourceData, repo *gocd.PackageRepository, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repo.ID)
+	d.Set("repo_id", repo.ID)
+	d.Set("name", repo.Name)
+	d.Set("version", repo.Version)
+
+	if repo.PluginMetadata != nil {
+		d.Set("plugin_id", repo.PluginMetadata.ID)
+		d.Set("plugin_version", repo.PluginMetadata.Version)
+	}
+
+	return d.Set("configuration", flattenPluginProperties(d, "configuration", repo.Configuration))
+}
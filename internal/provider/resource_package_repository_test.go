@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testGocdDebPackagePluginID = "deb"
+
+func testPackageRepository(t *testing.T) {
+	t.Run("Basic", testResourcePackageRepositoryBasic)
+	t.Run("Import", testResourcePackageRepositoryImportBasic)
+}
+
+func testResourcePackageRepositoryBasic(t *testing.T) {
+	testGocdPackagePlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPackageRepositoryDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_package_repository.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_package_repository.test-repository", "id", "test-repository"),
+					r.TestCheckResourceAttr("gocd_package_repository.test-repository", "plugin_id", testGocdDebPackagePluginID),
+					r.TestCheckResourceAttr("gocd_package_repository.test-repository", "plugin_version", "1"),
+					r.TestCheckResourceAttr("gocd_package_repository.test-repository", "configuration.#", "1"),
+				),
+			},
+			{
+				Config: testFile("resource_package_repository.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_package_repository.test-repository", "configuration.0.value", "http://deb.example.com/ubuntu"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePackageRepositoryImportBasic(t *testing.T) {
+	testGocdPackagePlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPackageRepositoryDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_package_repository.0.rsc.tf"), "test-repository", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_package_repository.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testGocdPackagePlugin skips the test unless the deb package material plugin is installed on the GoCD server.
+func testGocdPackagePlugin(t *testing.T) {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	if _, _, err := testGocdClient.Plugins.Get(context.Background(), testGocdDebPackagePluginID); err != nil {
+		t.Skipf("Plugin '%s' is not installed on the GoCD server.", testGocdDebPackagePluginID)
+	}
+}
+
+func testGocdPackageRepositoryDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_package_repository" {
+			continue
+		}
+
+		if _, _, err := gocdclient.PackageRepositories.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testPackage(t *testing.T) {
+	t.Run("Basic", testResourcePackageBasic)
+	t.Run("Import", testResourcePackageImportBasic)
+}
+
+func testResourcePackageBasic(t *testing.T) {
+	testGocdPackagePlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPackageDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_package.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_package.test-package", "id", "test-package"),
+					r.TestCheckResourceAttr("gocd_package.test-package", "repo_id", "test-repository"),
+					r.TestCheckResourceAttr("gocd_package.test-package", "auto_update", "true"),
+					r.TestCheckResourceAttr("gocd_package.test-package", "configuration.#", "1"),
+				),
+			},
+			{
+				Config: testFile("resource_package.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_package.test-package", "auto_update", "false"),
+					r.TestCheckResourceAttr("gocd_package.test-package", "configuration.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePackageImportBasic(t *testing.T) {
+	testGocdPackagePlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPackageDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_package.0.rsc.tf"), "test-package", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_package.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdPackageDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_package" {
+			continue
+		}
+
+		if _, _, err := gocdclient.Packages.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
@@ -3,22 +3,26 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"strings"
 )
 
 // codebeat:disable[LOC]
 func resourcePipeline() *schema.Resource {
+	stage := stageBlockSchema()
+	stage.ConflictsWith = []string{"template", "stages"}
+
 	return &schema.Resource{
-		Create:   resourcePipelineCreate,
-		Read:     resourcePipelineRead,
-		Update:   resourcePipelineUpdate,
-		Delete:   resourcePipelineDelete,
-		Exists:   resourcePipelineExists,
-		Importer: resourcePipelineStateImport(),
+		CreateContext: resourcePipelineCreate,
+		ReadContext:   resourcePipelineRead,
+		UpdateContext: resourcePipelineUpdate,
+		DeleteContext: resourcePipelineDelete,
+		Importer:      resourcePipelineStateImport(),
+		Timeouts:      resourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -67,6 +71,24 @@ func resourcePipeline() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the pipeline is paused. The pause state is left alone when not set.",
+			},
+			"start_paused": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"paused"},
+				Description:   "Create the pipeline paused, so it is not triggered by its materials straight away. Only used when the pipeline is created.",
+			},
+			"pause_reason": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reason the pipeline is paused, only used when `paused` or `start_paused` is `true`.",
+			},
 			"parameters": {
 				Type:     schema.TypeMap,
 				Elem:     schema.TypeString,
@@ -103,6 +125,75 @@ func resourcePipeline() *schema.Resource {
 					},
 				},
 			},
+			"timer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"spec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCronSpec,
+							Description:  "Quartz cron expression to trigger the pipeline on, eg `0 0 22 ? * MON-FRI`.",
+						},
+						"only_on_changes": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Only trigger the pipeline when its materials have changed.",
+						},
+					},
+				},
+			},
+			"tracking_tool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"generic",
+								"mingle",
+							}, false),
+						},
+						"attributes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"url_pattern": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "URL of the `generic` tracking tool, with `${ID}` replaced by the matched regex group.",
+									},
+									"regex": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Regex used by the `generic` tracking tool to match commit messages.",
+									},
+									"base_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"project_identifier": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mql_grouping_conditions": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"materials": {
 				Type:     schema.TypeList,
 				MinItems: 1,
@@ -170,6 +261,12 @@ func resourcePipeline() *schema.Resource {
 										Optional: true,
 										Computed: true,
 									},
+									"ref": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "Identifier of the package or pluggable SCM used by a `package` or `plugin` material.",
+									},
 									"auto_update": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -197,32 +294,40 @@ func resourcePipeline() *schema.Resource {
 				Type:          schema.TypeList,
 				MinItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"template"},
+				ConflictsWith: []string{"template", "stage"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 				DiffSuppressFunc: supressJSONDiffs,
+				Description:      "Stages as JSON documents, eg from `gocd_stage_definition`. Prefer `stage` blocks.",
 			},
+			"stage": stage,
 		},
 	}
 }
 
 // codebeat:enable[LOC]
 
-func resourcePipelineCreate(d *schema.ResourceData, meta interface{}) (err error) {
-	var p *gocd.Pipeline
-
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
-	if p, err = extractPipeline(d); err != nil {
-		return
+	p, err := extractPipeline(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &gocd.PipelineCreateOptions{
+		Paused:     d.Get("paused").(bool) || d.Get("start_paused").(bool),
+		PauseCause: d.Get("pause_reason").(string),
 	}
 
 	group := d.Get("group").(string)
-	pc, _, err := client.PipelineConfigs.Create(context.Background(), group, p)
-	return readPipeline(d, pc, err)
+	pc, _, err := client.PipelineConfigs.Create(ctx, group, p, opts)
+	if err = readPipeline(d, pc, err); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readPipelinePauseState(ctx, d, client))
 }
 
 func resourcePipelineParseStages(stages []string, doc *gocd.Pipeline) error {
@@ -236,30 +341,39 @@ func resourcePipelineParseStages(stages []string, doc *gocd.Pipeline) error {
 	return nil
 }
 
-func resourcePipelineRead(d *schema.ResourceData, meta interface{}) error {
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.Set("name", d.Get("name").(string))
 
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
-	ctx := context.Background()
 	pc, _, err := client.PipelineConfigs.Get(ctx, d.Id())
-	if err := readPipeline(d, pc, err); err != nil {
-		return err
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := readPipeline(d, pc, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := readPipelinePauseState(ctx, d, client); err != nil {
+		return diag.FromErr(err)
 	}
 
 	pipelineGroupReturnedSince, _ := version.NewVersion("v19.10.0")
-	v, _, err := client.ServerVersion.Get(context.Background())
+	v, _, err := client.ServerVersion.Get(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v.VersionParts.LessThan(pipelineGroupReturnedSince) {
 		pgs, _, err := client.PipelineGroups.List(ctx, "")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("group", pgs.GetGroupByPipelineName(d.Id()).Name)
 	}
@@ -267,64 +381,108 @@ func resourcePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourcePipelineUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var name string
-	var p *gocd.Pipeline
 	if pname, hasName := d.GetOk("name"); hasName {
 		name = pname.(string)
 		d.SetId(name)
 		d.Set("name", name)
 	}
 
-	if p, err = extractPipeline(d); err != nil {
-		return err
+	p, err := extractPipeline(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	client := meta.(*gocd.Client)
-	ctx := context.Background()
-	client.Lock()
-	defer client.Unlock()
+	// The pipeline is locked between reading its version and updating it, so that no other resource of this provider
+	// changes the pipeline in between.
+	client.Lock("pipelines/" + name)
+	defer client.Unlock("pipelines/" + name)
 
 	existing, _, err := client.PipelineConfigs.Get(ctx, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	p.Version = existing.Version
-	pc, _, err := client.PipelineConfigs.Update(ctx, name, p)
-	return readPipeline(d, pc, err)
+	var pc *gocd.Pipeline
+	conflicts, err := client.UpdateWithConflictResolution(ctx, existing, p,
+		func(ctx context.Context) (gocd.Versioned, error) {
+			current, _, err := client.PipelineConfigs.Get(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			return current, nil
+		},
+		func(ctx context.Context) (err error) {
+			pc, _, err = client.PipelineConfigs.Update(ctx, name, p)
+			return err
+		})
+	diags := conflictDiagnostics("pipeline", name, conflicts, err)
+	if diags.HasError() {
+		return diags
+	}
+	if err = readPipeline(d, pc, nil); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if d.HasChanges("paused", "pause_reason") {
+		if err = updatePipelinePauseState(ctx, d, client); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, diag.FromErr(readPipelinePauseState(ctx, d, client))...)
 }
 
-func resourcePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	var name string
-	if pname, hasName := d.GetOk("name"); hasName {
-		name = pname.(string)
+// updatePipelinePauseState pauses or unpauses the pipeline to match `paused`. GoCD can not change the reason of a
+// paused pipeline, so it is unpaused first.
+func updatePipelinePauseState(ctx context.Context, d *schema.ResourceData, client *gocd.Client) error {
+	status, _, err := client.Pipelines.GetStatus(ctx, d.Id(), 0)
+	if err != nil {
+		return err
 	}
-	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
-	_, _, err := client.PipelineConfigs.Delete(context.Background(), name)
+	if status.Paused {
+		if _, _, err = client.Pipelines.Unpause(ctx, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("paused").(bool) {
+		_, _, err = client.Pipelines.PauseWithCause(ctx, d.Id(), d.Get("pause_reason").(string))
+	}
 	return err
 }
 
-func resourcePipelineExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	name := d.Id()
+func readPipelinePauseState(ctx context.Context, d *schema.ResourceData, client *gocd.Client) error {
+	status, _, err := client.Pipelines.GetStatus(ctx, d.Id(), 0)
+	if err != nil {
+		return err
+	}
 
-	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
-	if p, _, err := client.PipelineConfigs.Get(context.Background(), name); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
-			return false, nil
-		} else {
-			return false, err
-		}
-	} else {
-		return (p.Name == name), nil
+	d.Set("paused", status.Paused)
+	if status.Paused {
+		d.Set("pause_reason", status.PausedCause)
 	}
+	return nil
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var name string
+	if pname, hasName := d.GetOk("name"); hasName {
+		name = pname.(string)
+	}
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.PipelineConfigs.Delete(ctx, name)
+	return diag.FromErr(err)
 }
 
 func resourcePipelineStateImport() *schema.ResourceImporter {
 	return &schema.ResourceImporter{
-		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 			d.Set("name", d.Id())
 			return []*schema.ResourceData{d}, nil
 		},
@@ -368,7 +526,21 @@ func extractPipeline(d *schema.ResourceData) (p *gocd.Pipeline, err error) {
 		p.EnvironmentVariables = dataSourceGocdJobEnvVarsRead(envVars)
 	}
 
-	if rStages, hasStages := d.GetOk("stages"); hasStages {
+	if rawTimer := d.Get("timer").([]interface{}); len(rawTimer) > 0 {
+		p.Timer = extractPipelineTimer(rawTimer[0].(map[string]interface{}))
+	}
+
+	if rawTrackingTool := d.Get("tracking_tool").([]interface{}); len(rawTrackingTool) > 0 {
+		if p.TrackingTool, err = extractPipelineTrackingTool(rawTrackingTool[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
+	}
+
+	if rawStages := d.Get("stage").([]interface{}); len(rawStages) > 0 {
+		if p.Stages, err = extractStages(rawStages); err != nil {
+			return nil, err
+		}
+	} else if rStages, hasStages := d.GetOk("stages"); hasStages {
 		if stages := decodeConfigStringList(rStages.([]interface{})); len(stages) > 0 {
 			resourcePipelineParseStages(stages, p)
 		}
@@ -377,6 +549,75 @@ func extractPipeline(d *schema.ResourceData) (p *gocd.Pipeline, err error) {
 	return p, nil
 }
 
+func extractPipelineTimer(timer map[string]interface{}) *gocd.Timer {
+	return &gocd.Timer{
+		Spec:          timer["spec"].(string),
+		OnlyOnChanges: timer["only_on_changes"].(bool),
+	}
+}
+
+func extractPipelineTrackingTool(trackingTool map[string]interface{}) (*gocd.TrackingTool, error) {
+	tt := &gocd.TrackingTool{
+		Type: trackingTool["type"].(string),
+	}
+
+	for _, rawAttributes := range trackingTool["attributes"].([]interface{}) {
+		attributes := rawAttributes.(map[string]interface{})
+		tt.Attributes = gocd.TrackingToolAttributes{
+			URLPattern:            attributes["url_pattern"].(string),
+			Regex:                 attributes["regex"].(string),
+			BaseURL:               attributes["base_url"].(string),
+			ProjectIdentifier:     attributes["project_identifier"].(string),
+			MqlGroupingConditions: attributes["mql_grouping_conditions"].(string),
+		}
+	}
+
+	switch tt.Type {
+	case "generic":
+		if tt.Attributes.URLPattern == "" || tt.Attributes.Regex == "" {
+			return nil, errors.New("`url_pattern` and `regex` are required for tracking tools of type 'generic'")
+		}
+	case "mingle":
+		if tt.Attributes.BaseURL == "" || tt.Attributes.ProjectIdentifier == "" {
+			return nil, errors.New("`base_url` and `project_identifier` are required for tracking tools of type 'mingle'")
+		}
+	}
+
+	return tt, nil
+}
+
+func readPipelineTimer(timer *gocd.Timer) []interface{} {
+	if timer == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"spec":            timer.Spec,
+			"only_on_changes": timer.OnlyOnChanges,
+		},
+	}
+}
+
+func readPipelineTrackingTool(tt *gocd.TrackingTool) []interface{} {
+	if tt == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"type": tt.Type,
+			"attributes": []interface{}{
+				map[string]interface{}{
+					"url_pattern":             tt.Attributes.URLPattern,
+					"regex":                   tt.Attributes.Regex,
+					"base_url":                tt.Attributes.BaseURL,
+					"project_identifier":      tt.Attributes.ProjectIdentifier,
+					"mql_grouping_conditions": tt.Attributes.MqlGroupingConditions,
+				},
+			},
+		},
+	}
+}
+
 func extractPipelineParameters(rawProperties map[string]interface{}) []*gocd.Parameter {
 	ps := []*gocd.Parameter{}
 	for key, value := range rawProperties {
@@ -489,19 +730,20 @@ func readPipeline(d *schema.ResourceData, p *gocd.Pipeline, err error) error {
 		ingestEnvironmentVariables(p.EnvironmentVariables),
 	)
 
-	err = readPipelineMaterials(d, p.Materials)
+	if err = d.Set("timer", readPipelineTimer(p.Timer)); err != nil {
+		return err
+	}
+
+	if err = d.Set("tracking_tool", readPipelineTrackingTool(p.TrackingTool)); err != nil {
+		return err
+	}
 
-	var s string
-	if stages := p.Stages; len(stages) > 0 {
-		stringStages := []string{}
-		for _, stage := range stages {
-			if s, err = stage.JSONString(); err != nil {
-				return err
-			}
-			stringStages = append(stringStages, s)
-		}
+	if err = readPipelineMaterials(d, p.Materials); err != nil {
+		return err
+	}
 
-		d.Set("stages", stringStages)
+	if err = readStages(d, p.Stages); err != nil {
+		return err
 	}
 
 	if len(p.Parameters) > 0 {
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePipelineGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePipelineGroupCreate,
+		ReadContext:   resourcePipelineGroupRead,
+		UpdateContext: resourcePipelineGroupUpdate,
+		DeleteContext: resourcePipelineGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePipelineGroupImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"view":    pipelineGroupAuthorizationSchema("Users and roles which may view the pipelines in the group."),
+			"operate": pipelineGroupAuthorizationSchema("Users and roles which may operate the pipelines in the group."),
+			"admins":  pipelineGroupAuthorizationSchema("Users and roles which may administer the pipeline group."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func pipelineGroupAuthorizationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"users": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"roles": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePipelineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	pg, _, err := client.PipelineGroups.Create(ctx, extractPipelineGroup(d))
+	return diag.FromErr(readPipelineGroup(d, pg, err))
+}
+
+func resourcePipelineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	pg, _, err := client.PipelineGroups.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readPipelineGroup(d, pg, nil))
+}
+
+func resourcePipelineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	group := extractPipelineGroup(d)
+	group.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	pg, _, err := client.PipelineGroups.Update(ctx, d.Id(), group)
+	return diag.FromErr(readPipelineGroup(d, pg, err))
+}
+
+func resourcePipelineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.PipelineGroups.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourcePipelineGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractPipelineGroup(d *schema.ResourceData) *gocd.PipelineGroup {
+	return &gocd.PipelineGroup{
+		Name: d.Get("name").(string),
+		Authorization: &gocd.PipelineGroupAuthorization{
+			View:    extractPipelineGroupAuthorizationMembers(d.Get("view").([]interface{})),
+			Operate: extractPipelineGroupAuthorizationMembers(d.Get("operate").([]interface{})),
+			Admins:  extractPipelineGroupAuthorizationMembers(d.Get("admins").([]interface{})),
+		},
+	}
+}
+
+func extractPipelineGroupAuthorizationMembers(raw []interface{}) *gocd.PipelineGroupAuthorizationMembers {
+	members := &gocd.PipelineGroupAuthorizationMembers{
+		Users: []string{},
+		Roles: []string{},
+	}
+	if len(raw) == 0 || raw[0] == nil {
+		return members
+	}
+
+	m := raw[0].(map[string]interface{})
+	members.Users = decodeConfigStringList(m["users"].(*schema.Set).List())
+	members.Roles = decodeConfigStringList(m["roles"].(*schema.Set).List())
+	return members
+}
+
+func readPipelineGroup(d *schema.ResourceData, pg *gocd.PipelineGroup, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(pg.Name)
+	d.Set("name", pg.Name)
+	d.Set("version", pg.Version)
+
+	auth := pg.Authorization
+	if auth == nil {
+		auth = &gocd.PipelineGroupAuthorization{}
+	}
+
+	for key, members := range map[string]*gocd.PipelineGroupAuthorizationMembers{
+		"view":    auth.View,
+		"operate": auth.Operate,
+		"admins":  auth.Admins,
+	} {
+		if err = d.Set(key, flattenPipelineGroupAuthorizationMembers(members)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenPipelineGroupAuthorizationMembers(members *gocd.PipelineGroupAuthorizationMembers) []interface{} {
+	if members == nil || (len(members.Users) == 0 && len(members.Roles) == 0) {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"users": members.Users,
+			"roles": members.Roles,
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testPipelineGroup(t *testing.T) {
+	t.Run("Basic", testResourcePipelineGroupBasic)
+	t.Run("Import", testResourcePipelineGroupImportBasic)
+}
+
+func testResourcePipelineGroupBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineGroupDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_pipeline_group.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "id", "test-group"),
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "view.0.users.#", "1"),
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "operate.#", "0"),
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "admins.0.roles.#", "1"),
+				),
+			},
+			{
+				Config: testFile("resource_pipeline_group.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "view.0.users.#", "2"),
+					r.TestCheckResourceAttr("gocd_pipeline_group.test-group", "operate.0.users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePipelineGroupImportBasic(t *testing.T) {
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineGroupDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_pipeline_group.0.rsc.tf"), "test-group", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_pipeline_group.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdPipelineGroupDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_pipeline_group" {
+			continue
+		}
+
+		if _, _, err := gocdclient.PipelineGroups.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
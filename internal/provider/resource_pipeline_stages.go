@@ -0,0 +1,662 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// codebeat:disable[LOC]
+
+// stageBlockSchema describes stages as nested `stage`, `job` and `task` blocks, mirroring the arguments of the
+// `gocd_stage_definition`, `gocd_job_definition` and `gocd_task_definition` data sources.
+func stageBlockSchema() *schema.Schema {
+	stringArg := &schema.Schema{Type: schema.TypeString}
+	optionalBoolArg := &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: "Stages, run in the order they are declared. An import sets `stages` instead, which the first " +
+			"apply replaces with the `stage` blocks of the configuration.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"fetch_materials": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"clean_working_directory": optionalBoolArg,
+				"never_cleanup_artifacts": optionalBoolArg,
+				"approval": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"manual",
+									"success",
+								}, false),
+							},
+							"authorization": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"users": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     stringArg,
+										},
+										"roles": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     stringArg,
+										},
+									},
+								},
+							},
+						},
+					},
+					Description: "Approval required to run the stage. Defaults to a `success` approval.",
+				},
+				"environment_variables": stageEnvironmentVariablesSchema(),
+				"job": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"run_instance_count": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"timeout": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"environment_variables": stageEnvironmentVariablesSchema(),
+							"resources": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     stringArg,
+							},
+							"elastic_profile_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"tabs": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"path": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"artifacts": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"type": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												"build",
+												"test",
+												"external",
+											}, false),
+										},
+										"source": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"destination": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"artifact_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"store_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"configuration": stageKeyValueSchema(),
+									},
+								},
+							},
+							"properties": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"source": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"xpath": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"task": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"type": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												"exec",
+												"ant",
+												"nant",
+												"rake",
+												"fetch",
+												"pluggable",
+											}, false),
+										},
+										"run_if": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Computed: true,
+											MaxItems: 3,
+											Elem:     stringArg,
+										},
+										"command": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"arguments": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     stringArg,
+										},
+										"working_directory": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"build_file": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"target": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"nant_path": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"pipeline": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"stage": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"job": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"source": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"is_source_a_file": optionalBoolArg,
+										"destination": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"artifact_origin": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												"gocd",
+												"external",
+											}, false),
+										},
+										"plugin_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"plugin_version": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"configuration": stageKeyValueSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func stageEnvironmentVariablesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"encrypted_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"secure": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func stageKeyValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// extractStages converts `stage` blocks into GoCD stages.
+func extractStages(rawStages []interface{}) ([]*gocd.Stage, error) {
+	stages := []*gocd.Stage{}
+	for _, rawStage := range rawStages {
+		stageMap := rawStage.(map[string]interface{})
+		stage := &gocd.Stage{
+			Name:                  stageMap["name"].(string),
+			FetchMaterials:        stageMap["fetch_materials"].(bool),
+			CleanWorkingDirectory: stageMap["clean_working_directory"].(bool),
+			NeverCleanupArtifacts: stageMap["never_cleanup_artifacts"].(bool),
+			Approval: &gocd.Approval{
+				Type: "success",
+				Authorization: &gocd.Authorization{
+					Users: []string{},
+					Roles: []string{},
+				},
+			},
+			EnvironmentVariables: extractEnvironmentVariables(stageMap["environment_variables"].([]interface{})),
+		}
+
+		for _, rawApproval := range stageMap["approval"].([]interface{}) {
+			approval := rawApproval.(map[string]interface{})
+			stage.Approval.Type = approval["type"].(string)
+			for _, rawAuthorization := range approval["authorization"].([]interface{}) {
+				if rawAuthorization == nil {
+					continue
+				}
+				authorization := rawAuthorization.(map[string]interface{})
+				stage.Approval.Authorization.Users = decodeConfigStringList(authorization["users"].(*schema.Set).List())
+				stage.Approval.Authorization.Roles = decodeConfigStringList(authorization["roles"].(*schema.Set).List())
+			}
+		}
+
+		for _, rawJob := range stageMap["job"].([]interface{}) {
+			job, err := extractJob(rawJob.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("stage '%s': %s", stage.Name, err)
+			}
+			stage.Jobs = append(stage.Jobs, job)
+		}
+
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+func extractJob(jobMap map[string]interface{}) (*gocd.Job, error) {
+	job := &gocd.Job{
+		Name:             jobMap["name"].(string),
+		RunInstanceCount: jobMap["run_instance_count"].(int),
+		Timeout:          gocd.TimeoutField(jobMap["timeout"].(int)),
+		ElasticProfileID: jobMap["elastic_profile_id"].(string),
+	}
+
+	if envVars := jobMap["environment_variables"].([]interface{}); len(envVars) > 0 {
+		job.EnvironmentVariables = extractEnvironmentVariables(envVars)
+	}
+
+	if resources := decodeConfigStringList(jobMap["resources"].(*schema.Set).List()); len(resources) > 0 {
+		if job.ElasticProfileID != "" {
+			return nil, fmt.Errorf("job '%s': `resources` and `elastic_profile_id` can not both be set", job.Name)
+		}
+		job.Resources = resources
+	}
+
+	for _, rawTab := range jobMap["tabs"].([]interface{}) {
+		tab := rawTab.(map[string]interface{})
+		job.Tabs = append(job.Tabs, &gocd.Tab{
+			Name: tab["name"].(string),
+			Path: tab["path"].(string),
+		})
+	}
+
+	for _, rawArtifact := range jobMap["artifacts"].([]interface{}) {
+		artifact, err := dataSourceGocdJobArtifactRead(rawArtifact.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("job '%s': %s", job.Name, err)
+		}
+		job.Artifacts = append(job.Artifacts, artifact)
+	}
+
+	if props := jobMap["properties"].([]interface{}); len(props) > 0 {
+		job.Properties = dataSourceGocdJobPropertiesRead(props)
+	}
+
+	for _, rawTask := range jobMap["task"].([]interface{}) {
+		task, err := extractTask(rawTask.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("job '%s': %s", job.Name, err)
+		}
+		job.Tasks = append(job.Tasks, task)
+	}
+
+	return job, nil
+}
+
+func extractTask(taskMap map[string]interface{}) (*gocd.Task, error) {
+	task := &gocd.Task{
+		Type: taskMap["type"].(string),
+		Attributes: gocd.TaskAttributes{
+			Command:          taskMap["command"].(string),
+			WorkingDirectory: taskMap["working_directory"].(string),
+			BuildFile:        taskMap["build_file"].(string),
+			Target:           taskMap["target"].(string),
+			NantPath:         taskMap["nant_path"].(string),
+			Pipeline:         taskMap["pipeline"].(string),
+			Stage:            taskMap["stage"].(string),
+			Job:              taskMap["job"].(string),
+			Source:           taskMap["source"].(string),
+			IsSourceAFile:    taskMap["is_source_a_file"].(bool),
+			Destination:      taskMap["destination"].(string),
+			ArtifactOrigin:   taskMap["artifact_origin"].(string),
+		},
+	}
+
+	if runIf := decodeConfigStringList(taskMap["run_if"].([]interface{})); len(runIf) > 0 {
+		task.Attributes.RunIf = runIf
+	}
+
+	if args := decodeConfigStringList(taskMap["arguments"].([]interface{})); len(args) > 0 {
+		task.Attributes.Arguments = args
+	}
+
+	switch task.Type {
+	case "exec":
+		if task.Attributes.Command == "" {
+			return nil, fmt.Errorf("`command` is required for tasks of type 'exec'")
+		}
+	case "fetch":
+		if task.Attributes.Stage == "" || task.Attributes.Job == "" || task.Attributes.Source == "" {
+			return nil, fmt.Errorf("`stage`, `job` and `source` are required for tasks of type 'fetch'")
+		}
+	case "pluggable":
+		pluginID := taskMap["plugin_id"].(string)
+		pluginVersion := taskMap["plugin_version"].(string)
+		if pluginID == "" || pluginVersion == "" {
+			return nil, fmt.Errorf("`plugin_id` and `plugin_version` are required for tasks of type 'pluggable'")
+		}
+		task.Attributes.PluginConfiguration = &gocd.TaskPluginConfiguration{
+			ID:      pluginID,
+			Version: pluginVersion,
+		}
+		task.Attributes.Configuration = []gocd.PluginConfigurationKVPair{}
+		for _, rawConfiguration := range taskMap["configuration"].([]interface{}) {
+			configuration := rawConfiguration.(map[string]interface{})
+			task.Attributes.Configuration = append(task.Attributes.Configuration, gocd.PluginConfigurationKVPair{
+				Key:   configuration["key"].(string),
+				Value: configuration["value"].(string),
+			})
+		}
+	}
+
+	return task, nil
+}
+
+// flattenStages converts GoCD stages into `stage` blocks. The current blocks are used to keep the plain text value of
+// secure environment variables, which GoCD only ever returns encrypted.
+func flattenStages(stages []*gocd.Stage, current []interface{}) []interface{} {
+	currentStages := nestedBlocksByName(current)
+
+	flattened := []interface{}{}
+	for _, stage := range stages {
+		currentStage := currentStages[stage.Name]
+		currentJobs := nestedBlocksByName(currentStage["job"])
+		currentEnvVars, _ := currentStage["environment_variables"].([]interface{})
+
+		stageMap := map[string]interface{}{
+			"name":                    stage.Name,
+			"fetch_materials":         stage.FetchMaterials,
+			"clean_working_directory": stage.CleanWorkingDirectory,
+			"never_cleanup_artifacts": stage.NeverCleanupArtifacts,
+			"approval":                flattenApproval(stage.Approval),
+			"environment_variables":   flattenEnvironmentVariables(currentEnvVars, stage.EnvironmentVariables),
+		}
+
+		jobs := []interface{}{}
+		for _, job := range stage.Jobs {
+			jobs = append(jobs, flattenJob(job, currentJobs[job.Name]))
+		}
+		stageMap["job"] = jobs
+
+		flattened = append(flattened, stageMap)
+	}
+	return flattened
+}
+
+func flattenApproval(approval *gocd.Approval) []interface{} {
+	if approval == nil {
+		return []interface{}{}
+	}
+
+	approvalMap := map[string]interface{}{
+		"type":          approval.Type,
+		"authorization": []interface{}{},
+	}
+	if a := approval.Authorization; a != nil && (len(a.Users) > 0 || len(a.Roles) > 0) {
+		approvalMap["authorization"] = []interface{}{
+			map[string]interface{}{
+				"users": a.Users,
+				"roles": a.Roles,
+			},
+		}
+	}
+	return []interface{}{approvalMap}
+}
+
+func flattenJob(job *gocd.Job, current map[string]interface{}) map[string]interface{} {
+	tabs := []interface{}{}
+	for _, tab := range job.Tabs {
+		tabs = append(tabs, map[string]interface{}{
+			"name": tab.Name,
+			"path": tab.Path,
+		})
+	}
+
+	artifacts := []interface{}{}
+	for _, artifact := range job.Artifacts {
+		configuration := []interface{}{}
+		for _, property := range artifact.Configuration {
+			configuration = append(configuration, map[string]interface{}{
+				"key":   property.Key,
+				"value": property.Value,
+			})
+		}
+		artifacts = append(artifacts, map[string]interface{}{
+			"type":          artifact.Type,
+			"source":        artifact.Source,
+			"destination":   artifact.Destination,
+			"artifact_id":   artifact.ID,
+			"store_id":      artifact.StoreID,
+			"configuration": configuration,
+		})
+	}
+
+	properties := []interface{}{}
+	for _, property := range job.Properties {
+		properties = append(properties, map[string]interface{}{
+			"name":   property.Name,
+			"source": property.Source,
+			"xpath":  property.XPath,
+		})
+	}
+
+	currentEnvVars, _ := current["environment_variables"].([]interface{})
+
+	tasks := []interface{}{}
+	for _, task := range job.Tasks {
+		tasks = append(tasks, flattenTask(task))
+	}
+
+	return map[string]interface{}{
+		"name":                  job.Name,
+		"run_instance_count":    job.RunInstanceCount,
+		"timeout":               int(job.Timeout),
+		"environment_variables": flattenEnvironmentVariables(currentEnvVars, job.EnvironmentVariables),
+		"resources":             job.Resources,
+		"elastic_profile_id":    job.ElasticProfileID,
+		"tabs":                  tabs,
+		"artifacts":             artifacts,
+		"properties":            properties,
+		"task":                  tasks,
+	}
+}
+
+func flattenTask(task *gocd.Task) map[string]interface{} {
+	attributes := task.Attributes
+	taskMap := map[string]interface{}{
+		"type":              task.Type,
+		"run_if":            attributes.RunIf,
+		"command":           attributes.Command,
+		"arguments":         attributes.Arguments,
+		"working_directory": attributes.WorkingDirectory,
+		"build_file":        attributes.BuildFile,
+		"target":            attributes.Target,
+		"nant_path":         attributes.NantPath,
+		"pipeline":          attributes.Pipeline,
+		"stage":             attributes.Stage,
+		"job":               attributes.Job,
+		"source":            attributes.Source,
+		"is_source_a_file":  attributes.IsSourceAFile,
+		"destination":       attributes.Destination,
+		"artifact_origin":   attributes.ArtifactOrigin,
+		"plugin_id":         "",
+		"plugin_version":    "",
+	}
+
+	if pc := attributes.PluginConfiguration; pc != nil {
+		taskMap["plugin_id"] = pc.ID
+		taskMap["plugin_version"] = pc.Version
+	}
+
+	configuration := []interface{}{}
+	for _, kv := range attributes.Configuration {
+		configuration = append(configuration, map[string]interface{}{
+			"key":   kv.Key,
+			"value": kv.Value,
+		})
+	}
+	taskMap["configuration"] = configuration
+
+	return taskMap
+}
+
+// nestedBlocksByName indexes a list of nested blocks by their `name` attribute.
+func nestedBlocksByName(rawBlocks interface{}) map[string]map[string]interface{} {
+	blocks := map[string]map[string]interface{}{}
+	rawList, _ := rawBlocks.([]interface{})
+	for _, rawBlock := range rawList {
+		if block, ok := rawBlock.(map[string]interface{}); ok {
+			blocks[block["name"].(string)] = block
+		}
+	}
+	return blocks
+}
+
+// readStages sets `stage` blocks when the configuration uses them, and `stages` as JSON documents otherwise. An import
+// has neither, so it keeps the JSON documents used before `stage` blocks existed.
+func readStages(d *schema.ResourceData, stages []*gocd.Stage) error {
+	if len(stages) == 0 {
+		return nil
+	}
+
+	if rawStages := d.Get("stage").([]interface{}); len(rawStages) > 0 {
+		return d.Set("stage", flattenStages(stages, rawStages))
+	}
+
+	stringStages := []string{}
+	for _, stage := range stages {
+		s, err := stage.JSONString()
+		if err != nil {
+			return err
+		}
+		stringStages = append(stringStages, s)
+	}
+	return d.Set("stages", stringStages)
+}
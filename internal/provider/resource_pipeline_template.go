@@ -3,25 +3,27 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"strings"
 )
 
 const PLACEHOLDER_NAME = "TERRAFORM_PLACEHOLDER"
 
 // codebeat:disable[LOC]
 func resourcePipelineTemplate() *schema.Resource {
+	stage := stageBlockSchema()
+	stage.ExactlyOneOf = []string{"stages", "stage"}
+
 	return &schema.Resource{
-		Create: resourcePipelineTemplateCreate,
-		Update: resourcePipelineTemplateUpdate,
-		Read:   resourcePipelineTemplateRead,
-		Delete: resourcePipelineTemplateDelete,
-		Exists: resourcePipelineTemplateExists,
+		CreateContext: resourcePipelineTemplateCreate,
+		UpdateContext: resourcePipelineTemplateUpdate,
+		ReadContext:   resourcePipelineTemplateRead,
+		DeleteContext: resourcePipelineTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourcePipelineTemplateImport,
+			StateContext: resourcePipelineTemplateImport,
 		},
+		Timeouts: resourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,119 +35,115 @@ func resourcePipelineTemplate() *schema.Resource {
 				Computed: true,
 			},
 			"stages": {
-				Type:     schema.TypeList,
-				MinItems: 1,
-				Required: true,
+				Type:         schema.TypeList,
+				MinItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"stages", "stage"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 				DiffSuppressFunc: supressJSONDiffs,
+				Description:      "Stages as JSON documents, eg from `gocd_stage_definition`. Prefer `stage` blocks.",
 			},
+			"stage": stage,
 		},
 	}
 }
 
 // codebeat:enable[LOC]
 
-func resourcePipelineTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourcePipelineTemplateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourcePipelineTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	var name string
-	if ptname, hasName := d.GetOk("name"); hasName {
-		name = ptname.(string)
-	} else {
-		return false, errors.New("`name` can not be empty")
-	}
-
-	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
-
-	if p, _, err := client.PipelineTemplates.Get(context.Background(), name); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
-			return false, nil
-		} else {
-			return false, err
-		}
-	} else {
-		return (p.Name == name), nil
-	}
-}
-
-func resourcePipelineTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePipelineTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var name string
 	if ptname, hasName := d.GetOk("name"); hasName {
 		name = ptname.(string)
 	}
 
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
 	pt := gocd.PipelineTemplate{}
-	resourcePipelineTemplateParseStages(d, &pt)
+	if err := resourcePipelineTemplateParseStages(d, &pt); err != nil {
+		return diag.FromErr(err)
+	}
 
-	pt2, _, err := client.PipelineTemplates.Create(context.Background(), name, pt.Stages)
-	return readPipelineTemplate(d, pt2, err)
+	pt2, _, err := client.PipelineTemplates.Create(ctx, name, pt.Stages)
+	return diag.FromErr(readPipelineTemplate(d, pt2, err))
 }
 
-func resourcePipelineTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourcePipelineTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var name string
 	if ptname, hasName := d.GetOk("name"); hasName {
 		name = ptname.(string)
 	}
 
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
 	pt := gocd.PipelineTemplate{
 		Name:    name,
 		Version: d.Get("version").(string),
 	}
 
-	resourcePipelineTemplateParseStages(d, &pt)
+	if err := resourcePipelineTemplateParseStages(d, &pt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	base, err := stateVersion(resourcePipelineTemplate(), d, nil, readPipelineTemplateVersion, extractPipelineTemplateVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	pt2, _, err := client.PipelineTemplates.Update(context.Background(), name, &pt)
-	return readPipelineTemplate(d, pt2, err)
+	var pt2 *gocd.PipelineTemplate
+	conflicts, err := client.UpdateWithConflictResolution(ctx, base, &pt,
+		func(ctx context.Context) (gocd.Versioned, error) {
+			current, _, err := client.PipelineTemplates.Get(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			return stateVersion(resourcePipelineTemplate(), d, current, readPipelineTemplateVersion, extractPipelineTemplateVersion)
+		},
+		func(ctx context.Context) (err error) {
+			pt2, _, err = client.PipelineTemplates.Update(ctx, name, &pt)
+			return err
+		})
+	diags := conflictDiagnostics("pipeline template", name, conflicts, err)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, diag.FromErr(readPipelineTemplate(d, pt2, nil))...)
 }
 
-func resourcePipelineTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourcePipelineTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var name string
 	if ptname, hasName := d.GetOk("name"); hasName {
 		name = ptname.(string)
 	}
 
 	var pt *gocd.PipelineTemplate
-	var resp *gocd.APIResponse
 	var err error
 	client := meta.(*gocd.Client)
-	client.Lock()
-	defer client.Unlock()
 
-	if pt, resp, err = client.PipelineTemplates.Get(context.Background(), name); err != nil {
-		if resp.HTTP.StatusCode == 404 {
+	if pt, _, err = client.PipelineTemplates.Get(ctx, name); err != nil {
+		if gocd.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
-	return readPipelineTemplate(d, pt, nil)
+	return diag.FromErr(readPipelineTemplate(d, pt, nil))
 
 }
 
-func resourcePipelineTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourcePipelineTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ptname, hasName := d.GetOk("name"); hasName {
 		client := meta.(*gocd.Client)
-		client.Lock()
-		defer client.Unlock()
 
-		if _, _, err := client.PipelineTemplates.Delete(context.Background(), ptname.(string)); err != nil {
-			return err
+		if _, _, err := client.PipelineTemplates.Delete(ctx, ptname.(string)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -161,23 +159,24 @@ func readPipelineTemplate(d *schema.ResourceData, p *gocd.PipelineTemplate, err
 	d.SetId(p.Name)
 	d.Set("version", p.Version)
 
-	var s string
-	if stages := p.Stages; len(stages) > 0 {
-		stringStages := []string{}
-		for _, stage := range stages {
-			if s, err = stage.JSONString(); err != nil {
-				return err
-			}
-			stringStages = append(stringStages, s)
-		}
+	return readStages(d, p.Stages)
+}
 
-		d.Set("stages", stringStages)
-	}
+func extractPipelineTemplateVersion(d *schema.ResourceData) (gocd.Versioned, error) {
+	pt := &gocd.PipelineTemplate{Name: d.Get("name").(string)}
+	return pt, resourcePipelineTemplateParseStages(d, pt)
+}
 
-	return nil
+func readPipelineTemplateVersion(d *schema.ResourceData, v gocd.Versioned) error {
+	return readPipelineTemplate(d, v.(*gocd.PipelineTemplate), nil)
 }
 
-func resourcePipelineTemplateParseStages(d *schema.ResourceData, pt *gocd.PipelineTemplate) error {
+func resourcePipelineTemplateParseStages(d *schema.ResourceData, pt *gocd.PipelineTemplate) (err error) {
+
+	if rawStages := d.Get("stage").([]interface{}); len(rawStages) > 0 {
+		pt.Stages, err = extractStages(rawStages)
+		return err
+	}
 
 	if rStages, hasStages := d.GetOk("stages"); hasStages {
 		if stages := decodeConfigStringList(rStages.([]interface{})); len(stages) > 0 {
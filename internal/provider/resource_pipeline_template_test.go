@@ -14,7 +14,7 @@ import (
 func testResourcePipelineTemplate(t *testing.T) {
 	t.Run("Basic", testResourcePipelineTemplateBasic)
 	t.Run("ImportBasic", testResourcePipelineTemplateImportBasic)
-	t.Run("Exists", testResourcePipelineTemplateExists)
+	t.Run("ImportStageBlocks", testResourcePipelineTemplateImportStageBlocks)
 	t.Run("PipelineReadHelper", testResourcePipelineTemplateReadHelper)
 	t.Run("Missing", testResourcePipelineTemplateMissing)
 }
@@ -33,16 +33,6 @@ func testResourcePipelineTemplateReadHelperMissingName(t *testing.T) {
 	assert.EqualError(t, err, "mock-error")
 }
 
-func testResourcePipelineTemplateExists(t *testing.T) {
-	rd := (&schema.Resource{Schema: map[string]*schema.Schema{
-		"name": {Type: schema.TypeString, Required: true},
-	}}).Data(&terraform.InstanceState{})
-
-	exists, err := resourcePipelineTemplateExists(rd, nil)
-	assert.False(t, exists)
-	assert.EqualError(t, err, "`name` can not be empty")
-}
-
 func testResourcePipelineTemplateBasic(t *testing.T) {
 
 	r.Test(t, r.TestCase{
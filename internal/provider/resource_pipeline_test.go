@@ -2,19 +2,261 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
 	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func testResourcePipeline(t *testing.T) {
 	t.Run("Basic", testResourcePipelineBasic)
 	t.Run("ImportBasic", testResourcePipelineImportBasic)
+	t.Run("ImportStageBlocks", testResourcePipelineImportStageBlocks)
 	t.Run("FullStack1", testResourcePipelineFullStack1)
 	t.Run("FullStack2", testResourcePipelineFullStack2)
 	t.Run("DisableAutoUpdate", testResourcePipelineDisableAutoUpdate)
 	t.Run("LinkedDependencies", testResourcePipelineLinkedDependencies)
 	t.Run("LinkedDependencies", testResourcePipelineLinkedDependencies)
 	t.Run("Missing", testResourcePipelineMissing)
+	t.Run("StageBlocks", testResourcePipelineStageBlocks)
+	t.Run("StageBlocksHelpers", testResourcePipelineStageBlocksHelpers)
+	t.Run("ReadStages", testResourcePipelineReadStages)
+	t.Run("TimerAndTrackingTool", testResourcePipelineTimerAndTrackingTool)
+	t.Run("TrackingToolHelpers", testResourcePipelineTrackingToolHelpers)
+	t.Run("Paused", testResourcePipelinePaused)
+	t.Run("StartPaused", testResourcePipelineStartPaused)
+}
+
+func testResourcePipelineStartPaused(t *testing.T) {
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_pipeline.12.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "paused", "true"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "pause_reason", "Created by Terraform"),
+					testCheckPipelinePaused("pipeline12-terraform", true),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePipelinePaused(t *testing.T) {
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_pipeline.10.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "paused", "true"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "pause_reason", "Waiting for upstream pipelines"),
+					testCheckPipelinePaused("pipeline10-terraform", true),
+				),
+			},
+			{
+				Config: testFile("resource_pipeline.11.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "paused", "false"),
+					testCheckPipelinePaused("pipeline10-terraform", false),
+				),
+			},
+		},
+	})
+}
+
+func testCheckPipelinePaused(name string, paused bool) r.TestCheckFunc {
+	return func(s *terraform.State) error {
+		status, _, err := testGocdClient.Pipelines.GetStatus(context.Background(), name, 0)
+		if err != nil {
+			return err
+		}
+		if status.Paused != paused {
+			return fmt.Errorf("expected pipeline '%s' paused to be %t, got %t", name, paused, status.Paused)
+		}
+		return nil
+	}
+}
+
+func testResourcePipelineTimerAndTrackingTool(t *testing.T) {
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_pipeline.8.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "timer.0.spec", "0 0 22 ? * MON-FRI"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "timer.0.only_on_changes", "true"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "tracking_tool.0.type", "generic"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "tracking_tool.0.attributes.0.regex", "##(\\d+)"),
+				),
+			},
+			{
+				Config: testFile("resource_pipeline.9.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "timer.0.spec", "0 30 2 * * ?"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "timer.0.only_on_changes", "false"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "tracking_tool.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePipelineTrackingToolHelpers(t *testing.T) {
+	tt := &gocd.TrackingTool{
+		Type: "mingle",
+		Attributes: gocd.TrackingToolAttributes{
+			BaseURL:           "https://mingle.example.com",
+			ProjectIdentifier: "gocd",
+		},
+	}
+
+	flattened := readPipelineTrackingTool(tt)
+	assert.Len(t, flattened, 1)
+
+	extracted, err := extractPipelineTrackingTool(flattened[0].(map[string]interface{}))
+	assert.NoError(t, err)
+	assert.Equal(t, tt, extracted)
+
+	_, err = extractPipelineTrackingTool(readPipelineTrackingTool(&gocd.TrackingTool{Type: "generic"})[0].(map[string]interface{}))
+	assert.EqualError(t, err, "`url_pattern` and `regex` are required for tracking tools of type 'generic'")
+
+	assert.Empty(t, readPipelineTrackingTool(nil))
+	assert.Empty(t, readPipelineTimer(nil))
+}
+
+func testResourcePipelineStageBlocks(t *testing.T) {
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdPipelineDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_pipeline.6.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "stage.0.name", "test"),
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "stage.0.job.0.task.0.arguments.1", "world"),
+				),
+			},
+			{
+				Config: testFile("resource_pipeline.7.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_pipeline.test-pipeline", "stage.0.job.0.task.0.arguments.1", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePipelineStageBlocksHelpers(t *testing.T) {
+	s := &gocd.Stage{
+		Name:           "build",
+		FetchMaterials: true,
+		Approval: &gocd.Approval{
+			Type: "manual",
+			Authorization: &gocd.Authorization{
+				Users: []string{"deployer"},
+				Roles: []string{},
+			},
+		},
+		EnvironmentVariables: []*gocd.EnvironmentVariable{
+			{Name: "TOKEN", EncryptedValue: "encrypted", Secure: true},
+		},
+		Jobs: []*gocd.Job{
+			{
+				Name:      "compile",
+				Timeout:   10,
+				Resources: []string{"linux"},
+				Tasks: []*gocd.Task{
+					{
+						Type: "exec",
+						Attributes: gocd.TaskAttributes{
+							RunIf:     []string{"passed"},
+							Command:   "make",
+							Arguments: []string{"build"},
+						},
+					},
+					{
+						Type: "pluggable",
+						Attributes: gocd.TaskAttributes{
+							RunIf: []string{"passed"},
+							PluginConfiguration: &gocd.TaskPluginConfiguration{
+								ID:      "script-executor",
+								Version: "1",
+							},
+							Configuration: []gocd.PluginConfigurationKVPair{
+								{Key: "script", Value: "echo hello"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rd := resourcePipelineTemplate().Data(&terraform.InstanceState{})
+	assert.NoError(t, rd.Set("stage", []interface{}{
+		map[string]interface{}{
+			"name": "build",
+			"environment_variables": []interface{}{
+				map[string]interface{}{"name": "TOKEN", "value": "secret", "secure": true},
+			},
+		},
+	}))
+	assert.NoError(t, rd.Set("stage", flattenStages([]*gocd.Stage{s}, rd.Get("stage").([]interface{}))))
+
+	assert.Equal(t, "secret", rd.Get("stage.0.environment_variables.0.value"))
+	assert.Equal(t, "encrypted", rd.Get("stage.0.environment_variables.0.encrypted_value"))
+	assert.Equal(t, "manual", rd.Get("stage.0.approval.0.type"))
+	assert.Equal(t, "script-executor", rd.Get("stage.0.job.0.task.1.plugin_id"))
+
+	stages, err := extractStages(rd.Get("stage").([]interface{}))
+	assert.NoError(t, err)
+	assert.Len(t, stages, 1)
+
+	// The plain text value of secure variables is sent instead of the encrypted value.
+	s.EnvironmentVariables[0] = &gocd.EnvironmentVariable{Name: "TOKEN", Value: "secret", Secure: true}
+	assert.Equal(t, s, stages[0])
+}
+
+func testResourcePipelineReadStages(t *testing.T) {
+	stages := []*gocd.Stage{{
+		Name:     "build",
+		Approval: &gocd.Approval{Type: "success"},
+		Jobs:     []*gocd.Job{{Name: "compile", Tasks: []*gocd.Task{{Type: "exec", Attributes: gocd.TaskAttributes{Command: "make"}}}}},
+	}}
+
+	// An import keeps the JSON documents.
+	rd := resourcePipeline().Data(&terraform.InstanceState{})
+	assert.NoError(t, readStages(rd, stages))
+	assert.Len(t, rd.Get("stages").([]interface{}), 1)
+	assert.Empty(t, rd.Get("stage").([]interface{}))
+
+	rd = resourcePipeline().Data(&terraform.InstanceState{})
+	assert.NoError(t, rd.Set("stages", []interface{}{`{"name": "build"}`}))
+	assert.NoError(t, readStages(rd, stages))
+	assert.Len(t, rd.Get("stages").([]interface{}), 1)
+	assert.Empty(t, rd.Get("stage").([]interface{}))
+
+	rd = resourcePipeline().Data(&terraform.InstanceState{})
+	assert.NoError(t, rd.Set("stage", []interface{}{map[string]interface{}{"name": "build"}}))
+	assert.NoError(t, readStages(rd, stages))
+	assert.Equal(t, "compile", rd.Get("stage.0.job.0.name"))
+	assert.Empty(t, rd.Get("stages").([]interface{}))
 }
 
 func testResourcePipelineLinkedDependencies(t *testing.T) {
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePluginSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePluginSettingsCreate,
+		ReadContext:   resourcePluginSettingsRead,
+		UpdateContext: resourcePluginSettingsUpdate,
+		DeleteContext: resourcePluginSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePluginSettingsImport,
+		},
+		CustomizeDiff: resourcePluginSettingsCustomizeDiff,
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Plugin to configure, eg `yaml.config.plugin`.",
+			},
+			"configuration": pluginPropertiesSchema("Plugin settings, validated against the `plugin_settings` of the plugin. Values of secure keys are encrypted by GoCD before they are saved."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePluginSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	client := meta.(*gocd.Client)
+
+	ps, err := extractPluginSettings(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Plugin settings cannot be deleted, so settings which already exist on the server are taken over.
+	existing, _, err := client.PluginSettings.Get(ctx, ps.PluginID)
+	if err == nil {
+		ps.Version = existing.Version
+		settings, _, err := client.PluginSettings.Update(ctx, ps)
+		return diag.FromErr(readPluginSettings(d, settings, err))
+	}
+	if !gocd.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	settings, _, err := client.PluginSettings.Create(ctx, ps)
+	return diag.FromErr(readPluginSettings(d, settings, err))
+}
+
+func resourcePluginSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	settings, _, err := client.PluginSettings.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readPluginSettings(d, settings, nil))
+}
+
+func resourcePluginSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	client := meta.(*gocd.Client)
+
+	ps, err := extractPluginSettings(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ps.Version = d.Get("version").(string)
+
+	settings, _, err := client.PluginSettings.Update(ctx, ps)
+	return diag.FromErr(readPluginSettings(d, settings, err))
+}
+
+// resourcePluginSettingsDelete only removes the plugin settings from the state, as GoCD does not allow them to be
+// deleted.
+func resourcePluginSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourcePluginSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("plugin_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourcePluginSettingsCustomizeDiff validates the configuration against the plugin settings advertised by the plugin,
+// so that unknown or missing keys are reported during plan rather than apply.
+func resourcePluginSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("plugin_id") && !d.HasChange("configuration") {
+		return nil
+	}
+	if !d.NewValueKnown("plugin_id") || !d.NewValueKnown("configuration") {
+		return nil
+	}
+
+	client := meta.(*gocd.Client)
+
+	pluginID := d.Get("plugin_id").(string)
+	plugin, err := getPluginInfo(ctx, client, pluginID)
+	if err != nil || plugin == nil {
+		return err
+	}
+
+	settings := plugin.GetPluginSettings()
+	if settings == nil {
+		return fmt.Errorf("plugin '%s' does not have any plugin settings", pluginID)
+	}
+
+	if err = settings.ValidateProperties(extractPluginProperties(d.Get("configuration").([]interface{}))); err != nil {
+		return fmt.Errorf("plugin '%s': %s", pluginID, err)
+	}
+	return nil
+}
+
+// extractPluginSettings builds the plugin settings from the resource, encrypting the values of keys which the plugin
+// marks as secure.
+func extractPluginSettings(ctx context.Context, d *schema.ResourceData, client *gocd.Client) (*gocd.PluginSettings, error) {
+	ps := &gocd.PluginSettings{
+		PluginID:      d.Get("plugin_id").(string),
+		Configuration: extractPluginProperties(d.Get("configuration").([]interface{})),
+	}
+
+	plugin, err := getPluginInfo(ctx, client, ps.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	if plugin == nil {
+		return ps, nil
+	}
+
+	settings := plugin.GetPluginSettings()
+	if settings == nil {
+		return ps, nil
+	}
+
+	if err = encryptSecurePluginProperties(ctx, client, *settings, ps.Configuration); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func readPluginSettings(d *schema.ResourceData, settings *gocd.PluginSettings, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.PluginID)
+	d.Set("plugin_id", settings.PluginID)
+	d.Set("version", settings.Version)
+
+	return d.Set("configuration", flattenPluginProperties(d, "configuration", settings.Configuration))
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testGocdYAMLConfigPluginID = "yaml.config.plugin"
+
+func testPluginSettings(t *testing.T) {
+	t.Run("Basic", testResourcePluginSettingsBasic)
+	t.Run("Import", testResourcePluginSettingsImportBasic)
+	t.Run("InvalidConfiguration", testResourcePluginSettingsInvalidConfiguration)
+}
+
+func testResourcePluginSettingsBasic(t *testing.T) {
+	testGocdPluginSettingsPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_plugin_settings.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_plugin_settings.test-settings", "id", testGocdYAMLConfigPluginID),
+					r.TestCheckResourceAttr("gocd_plugin_settings.test-settings", "configuration.#", "1"),
+					r.TestCheckResourceAttr("gocd_plugin_settings.test-settings", "configuration.0.value", "**/*.gocd.yaml"),
+				),
+			},
+			{
+				Config: testFile("resource_plugin_settings.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_plugin_settings.test-settings", "configuration.0.value", "**/*.gocd.yml"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePluginSettingsImportBasic(t *testing.T) {
+	testGocdPluginSettingsPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_plugin_settings.0.rsc.tf"),
+			},
+			{
+				ResourceName:      "gocd_plugin_settings.test-settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourcePluginSettingsInvalidConfiguration(t *testing.T) {
+	testGocdPluginSettingsPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config:      strings.Replace(testFile("resource_plugin_settings.0.rsc.tf"), "file_pattern", "pattern", -1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unknown key 'pattern'"),
+			},
+		},
+	})
+}
+
+// testGocdPluginSettingsPlugin skips the test unless the YAML config repository plugin is installed on the GoCD server.
+// The plugin settings are not checked on destroy, as GoCD does not allow them to be deleted.
+func testGocdPluginSettingsPlugin(t *testing.T) {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	if _, _, err := testGocdClient.Plugins.Get(context.Background(), testGocdYAMLConfigPluginID); err != nil {
+		t.Skipf("Plugin '%s' is not installed on the GoCD server.", testGocdYAMLConfigPluginID)
+	}
+}
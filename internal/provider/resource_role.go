@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	roleTypeGoCD   = "gocd"
+	roleTypePlugin = "plugin"
+)
+
+// codebeat:disable[LOC]
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					roleTypeGoCD,
+					roleTypePlugin,
+				}, false),
+			},
+			"users": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"auth_config_id", "properties"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Users which are members of a `gocd` role.",
+			},
+			"auth_config_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"users"},
+				Description:   "Authorization configuration used by a `plugin` role.",
+			},
+			"properties": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"users"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Description: "Plugin specific properties of a `plugin` role.",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	role, err := extractRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*gocd.Client)
+
+	r, _, err := client.Roles.Create(ctx, role)
+	return diag.FromErr(readRole(d, r, err))
+}
+
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	r, _, err := client.Roles.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readRole(d, r, nil))
+}
+
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	role, err := extractRole(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	role.Version = d.Get("version").(string)
+
+	base, err := stateVersion(resourceRole(), d, nil, readRoleVersion, extractRoleVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*gocd.Client)
+
+	var r *gocd.Role
+	conflicts, err := client.UpdateWithConflictResolution(ctx, base, role,
+		func(ctx context.Context) (gocd.Versioned, error) {
+			current, _, err := client.Roles.Get(ctx, d.Id())
+			if err != nil {
+				return nil, err
+			}
+			return stateVersion(resourceRole(), d, current, readRoleVersion, extractRoleVersion)
+		},
+		func(ctx context.Context) (err error) {
+			r, _, err = client.Roles.Update(ctx, d.Id(), role)
+			return err
+		})
+	diags := conflictDiagnostics("role", d.Id(), conflicts, err)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, diag.FromErr(readRole(d, r, nil))...)
+}
+
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.Roles.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractRole(d *schema.ResourceData) (*gocd.Role, error) {
+	role := &gocd.Role{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		Attributes: &gocd.RoleAttributesGoCD{},
+	}
+
+	authConfigID, hasAuthConfigID := d.GetOk("auth_config_id")
+
+	switch role.Type {
+	case roleTypeGoCD:
+		if hasAuthConfigID {
+			return nil, fmt.Errorf("`auth_config_id` can only be set for roles of type '%s'", roleTypePlugin)
+		}
+		role.Attributes.Users = decodeConfigStringList(d.Get("users").(*schema.Set).List())
+	case roleTypePlugin:
+		if !hasAuthConfigID {
+			return nil, fmt.Errorf("`auth_config_id` is required for roles of type '%s'", roleTypePlugin)
+		}
+		role.Attributes.AuthConfigID = gocd.String(authConfigID.(string))
+		for _, rawProperty := range d.Get("properties").([]interface{}) {
+			property := rawProperty.(map[string]interface{})
+			role.Attributes.Properties = append(role.Attributes.Properties, &gocd.RoleAttributeProperties{
+				Key:   property["key"].(string),
+				Value: property["value"].(string),
+			})
+		}
+	}
+
+	return role, nil
+}
+
+func extractRoleVersion(d *schema.ResourceData) (gocd.Versioned, error) {
+	return extractRole(d)
+}
+
+func readRoleVersion(d *schema.ResourceData, v gocd.Versioned) error {
+	return readRole(d, v.(*gocd.Role), nil)
+}
+
+func readRole(d *schema.ResourceData, r *gocd.Role, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(r.Name)
+	d.Set("name", r.Name)
+	d.Set("type", r.Type)
+	d.Set("version", r.Version)
+
+	if r.Attributes == nil {
+		return nil
+	}
+
+	if r.Type == roleTypeGoCD {
+		return d.Set("users", r.Attributes.Users)
+	}
+
+	if r.Attributes.AuthConfigID != nil {
+		d.Set("auth_config_id", *r.Attributes.AuthConfigID)
+	}
+
+	properties := []map[string]interface{}{}
+	for _, property := range r.Attributes.Properties {
+		properties = append(properties, map[string]interface{}{
+			"key":   property.Key,
+			"value": property.Value,
+		})
+	}
+	return d.Set("properties", properties)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testRole(t *testing.T) {
+	t.Run("Basic", testResourceRoleBasic)
+	t.Run("Import", testResourceRoleImportBasic)
+}
+
+func testResourceRoleBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdRoleDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_role.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_role.test-role", "id", "test-role"),
+					r.TestCheckResourceAttr("gocd_role.test-role", "type", "gocd"),
+					r.TestCheckResourceAttr("gocd_role.test-role", "users.#", "2"),
+				),
+			},
+			{
+				Config: testFile("resource_role.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_role.test-role", "users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceRoleImportBasic(t *testing.T) {
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdRoleDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_role.0.rsc.tf"), "test-role", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_role.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdRoleDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_role" {
+			continue
+		}
+
+		if _, _, err := gocdclient.Roles.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
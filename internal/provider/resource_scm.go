@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSCM() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSCMCreate,
+		ReadContext:   resourceSCMRead,
+		UpdateContext: resourceSCMUpdate,
+		DeleteContext: resourceSCMDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSCMImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"scm_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Identifier referenced by the `ref` of `plugin` materials. Generated by GoCD when not set.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the material. Pluggable SCMs are managed by name in the GoCD API.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SCM plugin which polls the material, eg `github.pr`.",
+			},
+			"plugin_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1",
+			},
+			"auto_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"configuration": pluginPropertiesSchema("Plugin specific configuration of the material, eg the repository URL and credentials."),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSCMCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	scm, _, err := client.SCMs.Create(ctx, extractSCM(d))
+	return diag.FromErr(readSCM(d, scm, err))
+}
+
+func resourceSCMRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	scm, _, err := client.SCMs.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readSCM(d, scm, nil))
+}
+
+func resourceSCMUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s := extractSCM(d)
+	s.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	scm, _, err := client.SCMs.Update(ctx, d.Id(), s)
+	return diag.FromErr(readSCM(d, scm, err))
+}
+
+func resourceSCMDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.SCMs.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceSCMImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractSCM(d *schema.ResourceData) *gocd.SCM {
+	return &gocd.SCM{
+		ID:         d.Get("scm_id").(string),
+		Name:       d.Get("name").(string),
+		AutoUpdate: d.Get("auto_update").(bool),
+		PluginMetadata: &gocd.PluginMetadata{
+			ID:      d.Get("plugin_id").(string),
+			Version: d.Get("plugin_version").(string),
+		},
+		Configuration: extractPluginProperties(d.Get("configuration").([]interface{})),
+	}
+}
+
+func readSCM(d *schema.ResourceData, scm *gocd.SCM, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(scm.Name)
+	d.Set("scm_id", scm.ID)
+	d.Set("name", scm.Name)
+	d.Set("auto_update", scm.AutoUpdate)
+	d.Set("version", scm.Version)
+
+	if scm.PluginMetadata != nil {
+		d.Set("plugin_id", scm.PluginMetadata.ID)
+		d.Set("plugin_version", scm.PluginMetadata.Version)
+	}
+
+	return d.Set("configuration", flattenPluginProperties(d, "configuration", scm.Configuration))
+}
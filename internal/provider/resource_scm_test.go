@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+const testGocdGitHubPRSCMPluginID = "github.pr"
+
+func testSCM(t *testing.T) {
+	t.Run("Basic", testResourceSCMBasic)
+	t.Run("Import", testResourceSCMImportBasic)
+}
+
+func testResourceSCMBasic(t *testing.T) {
+	testGocdSCMPlugin(t)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdSCMDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_scm.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "id", "test-scm"),
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "plugin_id", testGocdGitHubPRSCMPluginID),
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "scm_id", "test-scm"),
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "auto_update", "true"),
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "configuration.#", "1"),
+				),
+			},
+			{
+				Config: testFile("resource_scm.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_scm.test-scm", "auto_update", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceSCMImportBasic(t *testing.T) {
+	testGocdSCMPlugin(t)
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdSCMDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_scm.0.rsc.tf"), "test-scm", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_scm.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testGocdSCMPlugin skips the test unless the GitHub pull request SCM plugin is installed on the GoCD server.
+func testGocdSCMPlugin(t *testing.T) {
+	if os.Getenv(r.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", r.TestEnvVar)
+	}
+
+	if _, _, err := testGocdClient.Plugins.Get(context.Background(), testGocdGitHubPRSCMPluginID); err != nil {
+		t.Skipf("Plugin '%s' is not installed on the GoCD server.", testGocdGitHubPRSCMPluginID)
+	}
+}
+
+func testGocdSCMDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_scm" {
+			continue
+		}
+
+		if _, _, err := gocdclient.SCMs.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
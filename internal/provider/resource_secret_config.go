@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// codebeat:disable[LOC]
+func resourceSecretConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecretConfigCreate,
+		ReadContext:   resourceSecretConfigRead,
+		UpdateContext: resourceSecretConfigUpdate,
+		DeleteContext: resourceSecretConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecretConfigImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"secret_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier used to reference secrets, eg `{{SECRET:[secret_config_id][key]}}`.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Secret plugin which resolves the secrets, eg `cd.go.secrets.file-based-plugin`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"properties": pluginPropertiesSchema("Plugin specific configuration of the secret plugin."),
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directive": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "refer",
+							ValidateFunc: validation.StringInSlice([]string{"refer", "*"}, false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"pipeline_group", "environment", "*"}, false),
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the entity the rule applies to. May contain `*` wildcards.",
+						},
+					},
+				},
+				Description: "Rules controlling which pipeline groups and environments may refer to the secrets. The first matching rule applies, and anything not allowed is denied.",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func resourceSecretConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	config, _, err := client.SecretConfigs.Create(ctx, extractSecretConfig(d))
+	return diag.FromErr(readSecretConfig(d, config, err))
+}
+
+func resourceSecretConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	config, _, err := client.SecretConfigs.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readSecretConfig(d, config, nil))
+}
+
+func resourceSecretConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sc := extractSecretConfig(d)
+	sc.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+
+	config, _, err := client.SecretConfigs.Update(ctx, d.Id(), sc)
+	return diag.FromErr(readSecretConfig(d, config, err))
+}
+
+func resourceSecretConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	_, _, err := client.SecretConfigs.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceSecretConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("secret_config_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractSecretConfig(d *schema.ResourceData) *gocd.SecretConfig {
+	sc := &gocd.SecretConfig{
+		ID:          d.Get("secret_config_id").(string),
+		PluginID:    d.Get("plugin_id").(string),
+		Description: d.Get("description").(string),
+		Properties:  extractPluginProperties(d.Get("properties").([]interface{})),
+		Rules:       []*gocd.Rule{},
+	}
+
+	for _, rawRule := range d.Get("rules").([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		sc.Rules = append(sc.Rules, &gocd.Rule{
+			Directive: rule["directive"].(string),
+			Action:    rule["action"].(string),
+			Type:      rule["type"].(string),
+			Resource:  rule["resource"].(string),
+		})
+	}
+
+	return sc
+}
+
+func readSecretConfig(d *schema.ResourceData, config *gocd.SecretConfig, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(config.ID)
+	d.Set("secret_config_id", config.ID)
+	d.Set("plugin_id", config.PluginID)
+	d.Set("description", config.Description)
+	d.Set("version", config.Version)
+
+	if err = d.Set("properties", flattenPluginProperties(d, "properties", config.Properties)); err != nil {
+		return err
+	}
+
+	rules := []interface{}{}
+	for _, rule := range config.Rules {
+		rules = append(rules, map[string]interface{}{
+			"directive": rule.Directive,
+			"action":    rule.Action,
+			"type":      rule.Type,
+			"resource":  rule.Resource,
+		})
+	}
+	return d.Set("rules", rules)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testSecretConfig(t *testing.T) {
+	t.Run("Basic", testResourceSecretConfigBasic)
+	t.Run("Import", testResourceSecretConfigImportBasic)
+}
+
+func testResourceSecretConfigBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdSecretConfigDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_secret_config.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "id", "test-secrets"),
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "plugin_id", "cd.go.secrets.file-based-plugin"),
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "rules.#", "1"),
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "rules.0.action", "refer"),
+				),
+			},
+			{
+				Config: testFile("resource_secret_config.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "rules.#", "3"),
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "rules.0.directive", "deny"),
+					r.TestCheckResourceAttr("gocd_secret_config.test-secrets", "rules.2.action", "*"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceSecretConfigImportBasic(t *testing.T) {
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdSecretConfigDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_secret_config.0.rsc.tf"), "test-secrets", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_secret_config.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdSecretConfigDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_secret_config" {
+			continue
+		}
+
+		if _, _, err := gocdclient.SecretConfigs.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
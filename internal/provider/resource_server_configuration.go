@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"strconv"
+)
+
+// serverConfigurationID is the id of the gocd_server_configuration resource, as a GoCD server has a single
+// configuration.
+const serverConfigurationID = "server_configuration"
+
+// codebeat:disable[LOC]
+func resourceServerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerConfigurationCreate,
+		ReadContext:   resourceServerConfigurationRead,
+		UpdateContext: resourceServerConfigurationUpdate,
+		DeleteContext: resourceServerConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServerConfigurationImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"site_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL used by GoCD to generate links to itself in emails and notifications, eg `http://ci.example.com/go`.",
+			},
+			"secure_site_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "HTTPS URL used by GoCD when a secure link is required, eg `https://ci.example.com/go`.",
+			},
+			"artifacts_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Directory in which artifacts are stored, relative to the GoCD server installation directory when not absolute. Left as it is when not set.",
+			},
+			"purge_start_disk_space": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"purge_upto_disk_space"},
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Free disk space, in GB, below which old artifacts are purged. Artifacts are never purged when not set.",
+			},
+			"purge_upto_disk_space": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"purge_start_disk_space"},
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Free disk space, in GB, at which the purging of old artifacts stops.",
+			},
+			"job_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minutes after which jobs which have stopped producing output are cancelled. `0` never cancels jobs. Left as it is when not set.",
+			},
+			"mail_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"encrypted_password": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"tls": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"sender_email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address emails are sent from.",
+						},
+						"admin_email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address which receives emails about server problems, eg low disk space.",
+						},
+					},
+				},
+				Description: "SMTP server used to send notifications. The mail server is removed when not set. Creating the " +
+					"resource never removes it, so the removal of an existing mail server shows in the next plan instead.",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// codebeat:enable[LOC]
+
+func resourceServerConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(serverConfigurationID)
+	return resourceServerConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceServerConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	client := meta.(*gocd.Client)
+
+	urls, _, err := client.ServerConfiguration.GetSiteURLs(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("site_url", urls.SiteURL)
+	d.Set("secure_site_url", urls.SecureSiteURL)
+
+	artifacts, _, err := client.ServerConfiguration.GetArtifactConfig(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	readArtifactConfig(d, artifacts)
+
+	timeout, _, err := client.ServerConfiguration.GetDefaultJobTimeout(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = readDefaultJobTimeout(d, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	mail, _, err := client.ServerConfiguration.GetMailServer(ctx)
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			return diag.FromErr(d.Set("mail_server", []interface{}{}))
+		}
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("mail_server", flattenMailServer(d, mail)))
+}
+
+func resourceServerConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateServerConfiguration(ctx, d, meta.(*gocd.Client)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceServerConfigurationRead(ctx, d, meta)
+}
+
+// updateServerConfiguration sends the parts of the server configuration which have changed, as each part has its own
+// API. When the resource is created, only the parts which are set are sent, so that adopting the configuration of an
+// existing server does not reset the others.
+func updateServerConfiguration(ctx context.Context, d *schema.ResourceData, client *gocd.Client) error {
+	client.Lock(serverConfigurationID)
+	defer client.Unlock(serverConfigurationID)
+
+	isNew := d.IsNewResource()
+	isSet := func(keys ...string) bool {
+		for _, key := range keys {
+			if _, ok := d.GetOkExists(key); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if (isNew && isSet("site_url", "secure_site_url")) || (!isNew && d.HasChanges("site_url", "secure_site_url")) {
+		if _, _, err := client.ServerConfiguration.UpdateSiteURLs(ctx, &gocd.SiteURLs{
+			SiteURL:       d.Get("site_url").(string),
+			SecureSiteURL: d.Get("secure_site_url").(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	artifactKeys := []string{"artifacts_dir", "purge_start_disk_space", "purge_upto_disk_space"}
+	if (isNew && isSet(artifactKeys...)) || (!isNew && d.HasChanges(artifactKeys...)) {
+		ac := extractArtifactConfig(d)
+		ac.Version = d.Get("version").(string)
+
+		// The artifact configuration always exists, so its version, and its directory if it is not set, have to be
+		// looked up when it is first managed.
+		if isNew {
+			current, _, err := client.ServerConfiguration.GetArtifactConfig(ctx)
+			if err != nil {
+				return err
+			}
+			ac.Version = current.Version
+			if ac.ArtifactsDir == "" {
+				ac.ArtifactsDir = current.ArtifactsDir
+			}
+		}
+
+		if _, _, err := client.ServerConfiguration.UpdateArtifactConfig(ctx, ac); err != nil {
+			return err
+		}
+	}
+
+	if (isNew && isSet("job_timeout")) || (!isNew && d.HasChange("job_timeout")) {
+		if _, _, err := client.ServerConfiguration.UpdateDefaultJobTimeout(ctx, &gocd.DefaultJobTimeout{
+			DefaultJobTimeout: strconv.Itoa(d.Get("job_timeout").(int)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// The mail server is not removed when the resource is created without one.
+	if (isNew && len(d.Get("mail_server").([]interface{})) > 0) || (!isNew && d.HasChange("mail_server")) {
+		if err := updateMailServer(ctx, d, client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceServerConfigurationDelete only removes the server configuration from the state, as a GoCD server cannot be
+// left without a configuration.
+func resourceServerConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceServerConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(serverConfigurationID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateMailServer replaces the mail server configuration, or removes it when no mail server is configured.
+func updateMailServer(ctx context.Context, d *schema.ResourceData, client *gocd.Client) error {
+	rawMailServers := d.Get("mail_server").([]interface{})
+	if len(rawMailServers) == 0 {
+		_, _, err := client.ServerConfiguration.DeleteMailServer(ctx)
+		if err != nil && !gocd.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	mail := rawMailServers[0].(map[string]interface{})
+	ms := &gocd.MailServer{
+		Hostname:    mail["hostname"].(string),
+		Port:        mail["port"].(int),
+		Username:    mail["username"].(string),
+		Password:    mail["password"].(string),
+		TLS:         mail["tls"].(bool),
+		SenderEmail: mail["sender_email"].(string),
+		AdminEmail:  mail["admin_email"].(string),
+	}
+	// A plain text password takes precedence over an encrypted password remaining from a previous read.
+	if ms.Password == "" {
+		ms.EncryptedPassword = mail["encrypted_password"].(string)
+	}
+
+	_, _, err := client.ServerConfiguration.UpdateMailServer(ctx, ms)
+	return err
+}
+
+func extractArtifactConfig(d *schema.ResourceData) *gocd.ArtifactConfig {
+	ac := &gocd.ArtifactConfig{
+		ArtifactsDir: d.Get("artifacts_dir").(string),
+	}
+
+	start, startOk := d.GetOk("purge_start_disk_space")
+	upto, uptoOk := d.GetOk("purge_upto_disk_space")
+	if startOk && uptoOk {
+		ac.PurgeSettings = &gocd.ArtifactPurgeSettings{
+			PurgeStartDiskSpace: start.(float64),
+			PurgeUptoDiskSpace:  upto.(float64),
+		}
+	}
+
+	return ac
+}
+
+func readArtifactConfig(d *schema.ResourceData, config *gocd.ArtifactConfig) {
+	d.Set("artifacts_dir", config.ArtifactsDir)
+	d.Set("version", config.Version)
+
+	if config.PurgeSettings == nil {
+		d.Set("purge_start_disk_space", nil)
+		d.Set("purge_upto_disk_space", nil)
+		return
+	}
+	d.Set("purge_start_disk_space", config.PurgeSettings.PurgeStartDiskSpace)
+	d.Set("purge_upto_disk_space", config.PurgeSettings.PurgeUptoDiskSpace)
+}
+
+func readDefaultJobTimeout(d *schema.ResourceData, timeout *gocd.DefaultJobTimeout) error {
+	if timeout.DefaultJobTimeout == "" {
+		return d.Set("job_timeout", 0)
+	}
+
+	minutes, err := strconv.Atoi(timeout.DefaultJobTimeout)
+	if err != nil {
+		return err
+	}
+	return d.Set("job_timeout", minutes)
+}
+
+// flattenMailServer converts the mail server from the GoCD API into the resource schema. The password is only ever
+// returned encrypted, so the plain text password from the configuration is kept.
+func flattenMailServer(d *schema.ResourceData, server *gocd.MailServer) []interface{} {
+	password := ""
+	if rawMailServers := d.Get("mail_server").([]interface{}); len(rawMailServers) > 0 && rawMailServers[0] != nil {
+		password = rawMailServers[0].(map[string]interface{})["password"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"hostname":           server.Hostname,
+			"port":               server.Port,
+			"username":           server.Username,
+			"password":           password,
+			"encrypted_password": server.EncryptedPassword,
+			"tls":                server.TLS,
+			"sender_email":       server.SenderEmail,
+			"admin_email":        server.AdminEmail,
+		},
+	}
+}
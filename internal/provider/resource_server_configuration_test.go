@@ -0,0 +1,60 @@
+package provider
+
+import (
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func testServerConfiguration(t *testing.T) {
+	t.Run("Basic", testResourceServerConfigurationBasic)
+	t.Run("Import", testResourceServerConfigurationImportBasic)
+}
+
+func testResourceServerConfigurationBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_server_configuration.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "id", serverConfigurationID),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "site_url", "http://127.0.0.1:8153/go"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "artifacts_dir", "artifacts"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "purge_start_disk_space", "5"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "job_timeout", "60"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "mail_server.#", "0"),
+				),
+			},
+			{
+				Config: testFile("resource_server_configuration.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "secure_site_url", ""),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "purge_start_disk_space", "0"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "job_timeout", "0"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "mail_server.#", "1"),
+					r.TestCheckResourceAttr("gocd_server_configuration.test-server", "mail_server.0.hostname", "smtp.example.com"),
+					r.TestCheckResourceAttrSet("gocd_server_configuration.test-server", "mail_server.0.encrypted_password"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceServerConfigurationImportBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_server_configuration.1.rsc.tf"),
+			},
+			{
+				ResourceName:            "gocd_server_configuration.test-server",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mail_server.0.password"},
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// systemAdminsID is the id of the gocd_system_admins resource, as a GoCD server has a single list of system admins.
+const systemAdminsID = "system_admins"
+
+func resourceSystemAdmins() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSystemAdminsCreate,
+		ReadContext:   resourceSystemAdminsRead,
+		UpdateContext: resourceSystemAdminsUpdate,
+		DeleteContext: resourceSystemAdminsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSystemAdminsImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Login names of the users which are system admins.",
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Roles whose members are system admins. Note: GoCD treats every user as a system admin when neither `users` nor `roles` are set.",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSystemAdminsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	client := meta.(*gocd.Client)
+	client.Lock(systemAdminsID)
+	defer client.Unlock(systemAdminsID)
+
+	// The system admins always exist, so their version has to be looked up when they are first managed.
+	current, _, err := client.SystemAdmins.Get(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sa := extractSystemAdmins(d)
+	sa.Version = current.Version
+
+	admins, _, err := client.SystemAdmins.Update(ctx, sa)
+	return diag.FromErr(readSystemAdmins(d, admins, err))
+}
+
+func resourceSystemAdminsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	admins, _, err := client.SystemAdmins.Get(ctx)
+	return diag.FromErr(readSystemAdmins(d, admins, err))
+}
+
+func resourceSystemAdminsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sa := extractSystemAdmins(d)
+	sa.Version = d.Get("version").(string)
+
+	client := meta.(*gocd.Client)
+	client.Lock(systemAdminsID)
+	defer client.Unlock(systemAdminsID)
+
+	admins, _, err := client.SystemAdmins.Update(ctx, sa)
+	return diag.FromErr(readSystemAdmins(d, admins, err))
+}
+
+// resourceSystemAdminsDelete only removes the system admins from the state, as removing every system admin would make
+// every user a system admin.
+func resourceSystemAdminsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceSystemAdminsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(systemAdminsID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractSystemAdmins(d *schema.ResourceData) *gocd.SystemAdmins {
+	return &gocd.SystemAdmins{
+		Users: decodeConfigStringList(d.Get("users").(*schema.Set).List()),
+		Roles: decodeConfigStringList(d.Get("roles").(*schema.Set).List()),
+	}
+}
+
+func readSystemAdmins(d *schema.ResourceData, admins *gocd.SystemAdmins, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(systemAdminsID)
+	d.Set("version", admins.Version)
+
+	if err = d.Set("users", admins.Users); err != nil {
+		return err
+	}
+	return d.Set("roles", admins.Roles)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"strings"
+	"testing"
+)
+
+func testSystemAdmins(t *testing.T) {
+	t.Run("Basic", testResourceSystemAdminsBasic)
+	t.Run("Import", testResourceSystemAdminsImportBasic)
+}
+
+func testResourceSystemAdminsBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testSystemAdminsFile("resource_system_admins.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_system_admins.test-admins", "id", systemAdminsID),
+					r.TestCheckResourceAttr("gocd_system_admins.test-admins", "users.#", "1"),
+					r.TestCheckResourceAttr("gocd_system_admins.test-admins", "roles.#", "0"),
+				),
+			},
+			{
+				Config: testSystemAdminsFile("resource_system_admins.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_system_admins.test-admins", "users.#", "1"),
+					r.TestCheckResourceAttr("gocd_system_admins.test-admins", "roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceSystemAdminsImportBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testGocdProviders,
+		Steps: []r.TestStep{
+			{
+				Config: testSystemAdminsFile("resource_system_admins.0.rsc.tf"),
+			},
+			{
+				ResourceName:      "gocd_system_admins.test-admins",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testSystemAdminsFile loads a test configuration, making the user the tests run as a system admin so that the tests
+// can not lock themselves out of a server with security enabled.
+func testSystemAdminsFile(name string) string {
+	username := os.Getenv("GOCD_USERNAME")
+	if username == "" {
+		username = "admin"
+	}
+	return strings.Replace(testFile(name), "test-admin\"", username+"\"", -1)
+}
@@ -7,4 +7,21 @@ func TestResource(t *testing.T) {
 	t.Run("Pipeline", testResourcePipeline)
 	t.Run("Environment", testEnvironment)
 	t.Run("EnvironmentAssociation", testEnvironmentAssociation)
+	t.Run("Agent", testAgent)
+	t.Run("Role", testRole)
+	t.Run("ConfigRepo", testConfigRepo)
+	t.Run("PipelineGroup", testPipelineGroup)
+	t.Run("ClusterProfile", testClusterProfile)
+	t.Run("ElasticAgentProfile", testElasticAgentProfile)
+	t.Run("AuthConfig", testAuthConfig)
+	t.Run("SecretConfig", testSecretConfig)
+	t.Run("ArtifactStore", testArtifactStore)
+	t.Run("PackageRepository", testPackageRepository)
+	t.Run("Package", testPackage)
+	t.Run("SCM", testSCM)
+	t.Run("PluginSettings", testPluginSettings)
+	t.Run("ServerConfiguration", testServerConfiguration)
+	t.Run("User", testUser)
+	t.Run("SystemAdmins", testSystemAdmins)
+	t.Run("AccessToken", testAccessToken)
 }
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+		Timeouts: resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"login_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Disabled users can not log in, and are not counted against the GoCD user limit.",
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email_me": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send the user email notifications.",
+			},
+			"checkin_aliases": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names the user commits under, used to match commits to the user for notifications.",
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	user, _, err := client.Users.Create(ctx, extractUser(d))
+	return diag.FromErr(readUser(d, user, err))
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	user, _, err := client.Users.Get(ctx, d.Id())
+	if err != nil {
+		if gocd.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(readUser(d, user, nil))
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gocd.Client)
+
+	user, _, err := client.Users.Update(ctx, d.Id(), extractUser(d))
+	return diag.FromErr(readUser(d, user, err))
+}
+
+// resourceUserDelete disables the user before deleting it, as GoCD only deletes disabled users.
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	client := meta.(*gocd.Client)
+
+	if d.Get("enabled").(bool) {
+		u := extractUser(d)
+		u.Enabled = false
+		if _, _, err := client.Users.Update(ctx, d.Id(), u); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, _, err := client.Users.Delete(ctx, d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("login_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func extractUser(d *schema.ResourceData) *gocd.User {
+	return &gocd.User{
+		LoginName:      d.Get("login_name").(string),
+		Enabled:        d.Get("enabled").(bool),
+		Email:          d.Get("email").(string),
+		EmailMe:        d.Get("email_me").(bool),
+		CheckinAliases: decodeConfigStringList(d.Get("checkin_aliases").([]interface{})),
+	}
+}
+
+func readUser(d *schema.ResourceData, user *gocd.User, err error) error {
+	if err != nil {
+		return err
+	}
+
+	d.SetId(user.LoginName)
+	d.Set("login_name", user.LoginName)
+	d.Set("enabled", user.Enabled)
+	d.Set("email", user.Email)
+	d.Set("email_me", user.EmailMe)
+	d.Set("display_name", user.DisplayName)
+	d.Set("is_admin", user.Admin)
+
+	return d.Set("checkin_aliases", user.CheckinAliases)
+}
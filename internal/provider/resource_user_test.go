@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cloudandthings/terraform-provider-gocd/internal/gocd"
+	r "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func testUser(t *testing.T) {
+	t.Run("Basic", testResourceUserBasic)
+	t.Run("Import", testResourceUserImportBasic)
+}
+
+func testResourceUserBasic(t *testing.T) {
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdUserDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: testFile("resource_user.0.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_user.test-user", "id", "test-user"),
+					r.TestCheckResourceAttr("gocd_user.test-user", "enabled", "true"),
+					r.TestCheckResourceAttr("gocd_user.test-user", "email_me", "false"),
+					r.TestCheckResourceAttr("gocd_user.test-user", "checkin_aliases.#", "2"),
+				),
+			},
+			{
+				Config: testFile("resource_user.1.rsc.tf"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("gocd_user.test-user", "enabled", "false"),
+					r.TestCheckResourceAttr("gocd_user.test-user", "email_me", "true"),
+					r.TestCheckResourceAttr("gocd_user.test-user", "checkin_aliases.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceUserImportBasic(t *testing.T) {
+	suffix := randomString(10)
+
+	r.Test(t, r.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testGocdProviders,
+		CheckDestroy: testGocdUserDestroy,
+		Steps: []r.TestStep{
+			{
+				Config: strings.Replace(testFile("resource_user.0.rsc.tf"), "test-user", "test-"+suffix, -1),
+			},
+			{
+				ResourceName:      "gocd_user.test-" + suffix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGocdUserDestroy(s *terraform.State) error {
+	gocdclient := testGocdProvider.Meta().(*gocd.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gocd_user" {
+			continue
+		}
+
+		if _, _, err := gocdclient.Users.Get(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("still exists")
+		}
+	}
+
+	return nil
+}
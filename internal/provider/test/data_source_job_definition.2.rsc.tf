@@ -0,0 +1,45 @@
+data "gocd_task_definition" "test" {
+  type = "exec"
+
+  run_if = [
+    "passed",
+  ]
+
+  command = "docker"
+
+  arguments = [
+    "build",
+    "-t",
+    "app",
+    ".",
+  ]
+}
+
+data "gocd_job_definition" "test" {
+  name = "job-name"
+
+  tasks = [
+    data.gocd_task_definition.test.json,
+  ]
+
+  artifacts {
+    type   = "test"
+    source = "reports/*.xml"
+  }
+
+  artifacts {
+    type        = "external"
+    artifact_id = "app-image"
+    store_id    = "dockerhub"
+
+    configuration {
+      key   = "Image"
+      value = "example/app"
+    }
+
+    configuration {
+      key   = "Tag"
+      value = "$${GO_PIPELINE_LABEL}"
+    }
+  }
+}
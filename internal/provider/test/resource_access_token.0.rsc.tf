@@ -0,0 +1,3 @@
+resource "gocd_access_token" "test-token" {
+  description = "Terraform acceptance test"
+}
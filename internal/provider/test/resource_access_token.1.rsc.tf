@@ -0,0 +1,4 @@
+resource "gocd_access_token" "test-token" {
+  description  = "Terraform acceptance test"
+  revoke_cause = "Rotated by the acceptance test"
+}
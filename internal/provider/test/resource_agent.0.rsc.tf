@@ -0,0 +1,8 @@
+resource "gocd_agent" "test-agent" {
+  uuid               = "AGENT_UUID"
+  agent_config_state = "Enabled"
+  resources = [
+    "terraform",
+    "linux",
+  ]
+}
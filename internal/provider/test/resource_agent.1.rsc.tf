@@ -0,0 +1,7 @@
+resource "gocd_agent" "test-agent" {
+  uuid               = "AGENT_UUID"
+  agent_config_state = "Disabled"
+  resources = [
+    "terraform",
+  ]
+}
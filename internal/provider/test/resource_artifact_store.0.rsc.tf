@@ -0,0 +1,21 @@
+resource "gocd_artifact_store" "test-store" {
+  store_id  = "test-store"
+  plugin_id = "cd.go.artifact.docker.registry"
+
+  properties {
+    key   = "RegistryType"
+    value = "other"
+  }
+  properties {
+    key   = "RegistryURL"
+    value = "https://registry.example.com"
+  }
+  properties {
+    key   = "Username"
+    value = "gocd"
+  }
+  properties {
+    key   = "Password"
+    value = "secret"
+  }
+}
@@ -0,0 +1,10 @@
+resource "gocd_auth_config" "test-auth" {
+  auth_config_id    = "test-auth"
+  plugin_id         = "cd.go.authentication.passwordfile"
+  verify_connection = true
+
+  properties {
+    key   = "PasswordFilePath"
+    value = "PASSWORD_FILE"
+  }
+}
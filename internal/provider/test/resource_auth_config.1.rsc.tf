@@ -0,0 +1,11 @@
+resource "gocd_auth_config" "test-auth" {
+  auth_config_id                  = "test-auth"
+  plugin_id                       = "cd.go.authentication.passwordfile"
+  allow_only_known_users_to_login = true
+  verify_connection               = true
+
+  properties {
+    key   = "PasswordFilePath"
+    value = "PASSWORD_FILE"
+  }
+}
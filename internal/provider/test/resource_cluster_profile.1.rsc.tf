@@ -0,0 +1,21 @@
+resource "gocd_cluster_profile" "test-cluster" {
+  profile_id = "test-cluster"
+  plugin_id  = "cd.go.contrib.elastic-agent.docker"
+
+  properties {
+    key   = "go_server_url"
+    value = "https://gocd-server:8154/go"
+  }
+  properties {
+    key   = "docker_uri"
+    value = "unix:///var/run/docker.sock"
+  }
+  properties {
+    key   = "max_docker_containers"
+    value = "10"
+  }
+  properties {
+    key   = "auto_register_timeout"
+    value = "10"
+  }
+}
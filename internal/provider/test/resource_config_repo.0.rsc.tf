@@ -0,0 +1,18 @@
+resource "gocd_config_repo" "test-config-repo" {
+  repo_id   = "test-config-repo"
+  plugin_id = "json.config.plugin"
+
+  material {
+    type = "git"
+
+    attributes {
+      url    = "https://github.com/gocd/gocd-json-config-example.git"
+      branch = "master"
+    }
+  }
+
+  configuration {
+    key   = "pipeline_pattern"
+    value = "*.gopipeline.json"
+  }
+}
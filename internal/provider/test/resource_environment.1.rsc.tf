@@ -0,0 +1,14 @@
+resource "gocd_environment" "test-environment" {
+  name = "test-environment"
+
+  environment_variables {
+    name  = "DEPLOY_TARGET"
+    value = "staging"
+  }
+
+  environment_variables {
+    name   = "DEPLOY_TOKEN"
+    value  = "secret"
+    secure = true
+  }
+}
@@ -0,0 +1,9 @@
+resource "gocd_environment" "test-environment" {
+  name = "test-environment"
+
+  environment_variables {
+    name   = "DEPLOY_TOKEN"
+    value  = "rotated-secret"
+    secure = true
+  }
+}
@@ -0,0 +1,8 @@
+resource "gocd_agent" "test-agent" {
+  uuid = "AGENT_UUID"
+}
+
+resource "gocd_environment" "test-environment" {
+  name   = "test-environment"
+  agents = [gocd_agent.test-agent.uuid]
+}
@@ -0,0 +1,21 @@
+resource "gocd_package_repository" "test-repository" {
+  repo_id   = "test-repository"
+  name      = "test-repository"
+  plugin_id = "deb"
+
+  configuration {
+    key   = "REPO_URL"
+    value = "http://deb.example.com/debian"
+  }
+}
+
+resource "gocd_package" "test-package" {
+  package_id = "test-package"
+  name       = "test-package"
+  repo_id    = gocd_package_repository.test-repository.repo_id
+
+  configuration {
+    key   = "PACKAGE_NAME"
+    value = "gocd-agent"
+  }
+}
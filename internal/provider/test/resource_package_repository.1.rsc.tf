@@ -0,0 +1,10 @@
+resource "gocd_package_repository" "test-repository" {
+  repo_id   = "test-repository"
+  name      = "test-repository"
+  plugin_id = "deb"
+
+  configuration {
+    key   = "REPO_URL"
+    value = "http://deb.example.com/ubuntu"
+  }
+}
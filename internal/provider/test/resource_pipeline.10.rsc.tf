@@ -0,0 +1,33 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline10-terraform"
+  group = "testing"
+
+  paused       = true
+  pause_reason = "Waiting for upstream pipelines"
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+        arguments = [
+          "hello",
+          "world",
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,32 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline10-terraform"
+  group = "testing"
+
+  paused = false
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+        arguments = [
+          "hello",
+          "world",
+        ]
+      }
+    }
+  }
+}
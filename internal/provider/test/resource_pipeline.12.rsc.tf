@@ -0,0 +1,33 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline12-terraform"
+  group = "testing"
+
+  start_paused = true
+  pause_reason = "Created by Terraform"
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+        arguments = [
+          "hello",
+          "world",
+        ]
+      }
+    }
+  }
+}
@@ -0,0 +1,30 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline6-terraform"
+  group = "testing"
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+        arguments = [
+          "hello",
+          "terraform",
+        ]
+      }
+    }
+  }
+}
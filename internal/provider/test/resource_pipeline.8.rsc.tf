@@ -0,0 +1,40 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline8-terraform"
+  group = "testing"
+
+  timer {
+    spec            = "0 0 22 ? * MON-FRI"
+    only_on_changes = true
+  }
+
+  tracking_tool {
+    type = "generic"
+
+    attributes {
+      url_pattern = "https://github.com/gocd/gocd/issues/$${ID}"
+      regex       = "##(\\d+)"
+    }
+  }
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+      }
+    }
+  }
+}
@@ -0,0 +1,30 @@
+resource "gocd_pipeline" "test-pipeline" {
+  name  = "pipeline8-terraform"
+  group = "testing"
+
+  timer {
+    spec = "0 30 2 * * ?"
+  }
+
+  materials {
+    type = "git"
+
+    attributes {
+      name = "gocd-src"
+      url  = "git@github.com:gocd/gocd"
+    }
+  }
+
+  stage {
+    name = "test"
+
+    job {
+      name = "test"
+
+      task {
+        type    = "exec"
+        command = "echo"
+      }
+    }
+  }
+}
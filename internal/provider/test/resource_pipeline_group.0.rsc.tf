@@ -0,0 +1,17 @@
+resource "gocd_pipeline_group" "test-group" {
+  name = "test-group"
+
+  view {
+    users = ["alice"]
+  }
+
+  admins {
+    roles = ["admins"]
+  }
+}
+
+resource "gocd_role" "admins" {
+  name  = "admins"
+  type  = "gocd"
+  users = ["bob"]
+}
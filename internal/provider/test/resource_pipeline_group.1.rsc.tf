@@ -0,0 +1,21 @@
+resource "gocd_pipeline_group" "test-group" {
+  name = "test-group"
+
+  view {
+    users = ["alice", "carol"]
+  }
+
+  operate {
+    users = ["carol"]
+  }
+
+  admins {
+    roles = ["admins"]
+  }
+}
+
+resource "gocd_role" "admins" {
+  name  = "admins"
+  type  = "gocd"
+  users = ["bob"]
+}
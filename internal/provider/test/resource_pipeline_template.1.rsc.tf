@@ -0,0 +1,69 @@
+resource "gocd_pipeline_template" "test-pipeline" {
+  name = "template1-terraform"
+
+  stage {
+    name = "build"
+
+    environment_variables {
+      name  = "TARGET"
+      value = "release"
+    }
+
+    job {
+      name    = "compile"
+      timeout = 10
+
+      resources = [
+        "linux",
+      ]
+
+      task {
+        type    = "exec"
+        command = "make"
+        arguments = [
+          "build",
+        ]
+      }
+
+      artifacts {
+        type        = "build"
+        source      = "bin/"
+        destination = "bin"
+      }
+
+      tabs {
+        name = "coverage"
+        path = "coverage/index.html"
+      }
+    }
+  }
+
+  stage {
+    name = "deploy"
+
+    approval {
+      type = "manual"
+
+      authorization {
+        users = ["deployer"]
+      }
+    }
+
+    job {
+      name = "deploy"
+
+      task {
+        type     = "fetch"
+        stage    = "build"
+        job      = "compile"
+        source   = "bin/"
+      }
+
+      task {
+        type    = "exec"
+        command = "./deploy.sh"
+        run_if  = ["passed"]
+      }
+    }
+  }
+}
@@ -0,0 +1,8 @@
+resource "gocd_plugin_settings" "test-settings" {
+  plugin_id = "yaml.config.plugin"
+
+  configuration {
+    key   = "file_pattern"
+    value = "**/*.gocd.yml"
+  }
+}
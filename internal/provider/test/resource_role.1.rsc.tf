@@ -0,0 +1,7 @@
+resource "gocd_role" "test-role" {
+  name = "test-role"
+  type = "gocd"
+  users = [
+    "alice",
+  ]
+}
@@ -0,0 +1,10 @@
+resource "gocd_scm" "test-scm" {
+  scm_id    = "test-scm"
+  name      = "test-scm"
+  plugin_id = "github.pr"
+
+  configuration {
+    key   = "url"
+    value = "https://github.com/gocd/gocd.git"
+  }
+}
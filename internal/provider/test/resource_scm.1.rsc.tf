@@ -0,0 +1,11 @@
+resource "gocd_scm" "test-scm" {
+  scm_id      = "test-scm"
+  name        = "test-scm"
+  plugin_id   = "github.pr"
+  auto_update = false
+
+  configuration {
+    key   = "url"
+    value = "https://github.com/gocd/gocd.git"
+  }
+}
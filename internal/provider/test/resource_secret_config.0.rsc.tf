@@ -0,0 +1,16 @@
+resource "gocd_secret_config" "test-secrets" {
+  secret_config_id = "test-secrets"
+  plugin_id        = "cd.go.secrets.file-based-plugin"
+  description      = "Secrets for the test pipelines"
+
+  properties {
+    key   = "SecretsFilePath"
+    value = "/godata/config/secrets.json"
+  }
+
+  rules {
+    directive = "allow"
+    type      = "pipeline_group"
+    resource  = "test-*"
+  }
+}
@@ -0,0 +1,29 @@
+resource "gocd_secret_config" "test-secrets" {
+  secret_config_id = "test-secrets"
+  plugin_id        = "cd.go.secrets.file-based-plugin"
+  description      = "Secrets for the test pipelines and environments"
+
+  properties {
+    key   = "SecretsFilePath"
+    value = "/godata/config/secrets.json"
+  }
+
+  rules {
+    directive = "deny"
+    type      = "pipeline_group"
+    resource  = "test-private"
+  }
+
+  rules {
+    directive = "allow"
+    type      = "pipeline_group"
+    resource  = "test-*"
+  }
+
+  rules {
+    directive = "allow"
+    action    = "*"
+    type      = "environment"
+    resource  = "*"
+  }
+}
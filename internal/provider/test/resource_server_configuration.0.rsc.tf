@@ -0,0 +1,7 @@
+resource "gocd_server_configuration" "test-server" {
+  site_url               = "http://127.0.0.1:8153/go"
+  secure_site_url        = "https://127.0.0.1:8154/go"
+  purge_start_disk_space = 5
+  purge_upto_disk_space  = 10
+  job_timeout            = 60
+}
@@ -0,0 +1,14 @@
+resource "gocd_server_configuration" "test-server" {
+  site_url    = "http://127.0.0.1:8153/go"
+  job_timeout = 0
+
+  mail_server {
+    hostname     = "smtp.example.com"
+    port         = 465
+    username     = "gocd"
+    password     = "secret"
+    tls          = true
+    sender_email = "gocd@example.com"
+    admin_email  = "admin@example.com"
+  }
+}
@@ -0,0 +1,3 @@
+resource "gocd_system_admins" "test-admins" {
+  users = ["test-admin"]
+}
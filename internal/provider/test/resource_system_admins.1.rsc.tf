@@ -0,0 +1,10 @@
+resource "gocd_role" "test-admins" {
+  name  = "test-admins"
+  type  = "gocd"
+  users = ["test-admin"]
+}
+
+resource "gocd_system_admins" "test-admins" {
+  users = ["test-admin"]
+  roles = [gocd_role.test-admins.name]
+}
@@ -0,0 +1,5 @@
+resource "gocd_user" "test-user" {
+  login_name      = "test-user"
+  email           = "test-user@example.com"
+  checkin_aliases = ["test-user", "test.user@example.com"]
+}
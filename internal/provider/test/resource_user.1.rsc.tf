@@ -0,0 +1,7 @@
+resource "gocd_user" "test-user" {
+  login_name      = "test-user"
+  enabled         = false
+  email           = "test-user@example.com"
+  email_me        = true
+  checkin_aliases = ["test-user"]
+}